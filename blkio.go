@@ -27,6 +27,7 @@ import (
 	"strings"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
 )
 
 func NewBlkio(root string) *blkioController {
@@ -56,8 +57,12 @@ func (b *blkioController) Create(path string, resources *specs.LinuxResources) e
 	}
 	for _, t := range createBlkioSettings(resources.BlockIO) {
 		if t.value != nil {
+			name := t.name
+			if name == "weight" || name == "weight_device" {
+				name = b.weightFile(path, name)
+			}
 			if err := ioutil.WriteFile(
-				filepath.Join(b.Path(path), fmt.Sprintf("blkio.%s", t.name)),
+				filepath.Join(b.Path(path), fmt.Sprintf("blkio.%s", name)),
 				t.format(t.value),
 				defaultFilePerm,
 			); err != nil {
@@ -68,58 +73,91 @@ func (b *blkioController) Create(path string, resources *specs.LinuxResources) e
 	return nil
 }
 
+// weightFile returns the weight interface file to use for the cgroup.
+// Kernels without the CFQ scheduler only provide the BFQ scheduler's
+// blkio.bfq.* weight files.
+func (b *blkioController) weightFile(path, name string) string {
+	if _, err := os.Lstat(filepath.Join(b.Path(path), fmt.Sprintf("blkio.%s", name))); err != nil {
+		if _, err := os.Lstat(filepath.Join(b.Path(path), fmt.Sprintf("blkio.bfq.%s", name))); err == nil {
+			return fmt.Sprintf("bfq.%s", name)
+		}
+	}
+	return name
+}
+
 func (b *blkioController) Update(path string, resources *specs.LinuxResources) error {
 	return b.Create(path, resources)
 }
 
 func (b *blkioController) Stat(path string, stats *Metrics) error {
 	stats.Blkio = &BlkIOStat{}
-	settings := []blkioStatSettings{
-		{
-			name:  "throttle.io_serviced",
-			entry: &stats.Blkio.IoServicedRecursive,
-		},
-		{
-			name:  "throttle.io_service_bytes",
-			entry: &stats.Blkio.IoServiceBytesRecursive,
-		},
-	}
+	var settings []blkioStatSettings
 	// Try to read CFQ stats available on all CFQ enabled kernels first
-	if _, err := os.Lstat(filepath.Join(b.Path(path), fmt.Sprintf("blkio.io_serviced_recursive"))); err == nil {
-		settings = append(settings,
-			blkioStatSettings{
+	if _, err := os.Lstat(filepath.Join(b.Path(path), "blkio.io_serviced_recursive")); err == nil {
+		stats.Blkio.Hierarchical = true
+		settings = []blkioStatSettings{
+			{
 				name:  "sectors_recursive",
 				entry: &stats.Blkio.SectorsRecursive,
 			},
-			blkioStatSettings{
+			{
 				name:  "io_service_bytes_recursive",
 				entry: &stats.Blkio.IoServiceBytesRecursive,
 			},
-			blkioStatSettings{
+			{
 				name:  "io_serviced_recursive",
 				entry: &stats.Blkio.IoServicedRecursive,
 			},
-			blkioStatSettings{
+			{
 				name:  "io_queued_recursive",
 				entry: &stats.Blkio.IoQueuedRecursive,
 			},
-			blkioStatSettings{
+			{
 				name:  "io_service_time_recursive",
 				entry: &stats.Blkio.IoServiceTimeRecursive,
 			},
-			blkioStatSettings{
+			{
 				name:  "io_wait_time_recursive",
 				entry: &stats.Blkio.IoWaitTimeRecursive,
 			},
-			blkioStatSettings{
+			{
 				name:  "io_merged_recursive",
 				entry: &stats.Blkio.IoMergedRecursive,
 			},
-			blkioStatSettings{
+			{
 				name:  "time_recursive",
 				entry: &stats.Blkio.IoTimeRecursive,
 			},
-		)
+		}
+	} else if _, err := os.Lstat(filepath.Join(b.Path(path), "blkio.bfq.io_serviced_recursive")); err == nil {
+		// kernels without CFQ provide the same stats through the BFQ scheduler
+		stats.Blkio.Hierarchical = true
+		settings = []blkioStatSettings{
+			{
+				name:  "bfq.io_service_bytes_recursive",
+				entry: &stats.Blkio.IoServiceBytesRecursive,
+			},
+			{
+				name:  "bfq.io_serviced_recursive",
+				entry: &stats.Blkio.IoServicedRecursive,
+			},
+		}
+	}
+	// fall back to the throttling stats which are always available but
+	// only account for the cgroup itself, Hierarchical is left false so
+	// consumers know to sum the children themselves
+	throttle := []blkioStatSettings{
+		{
+			name:  "throttle.io_serviced",
+			entry: &stats.Blkio.IoServicedRecursive,
+		},
+		{
+			name:  "throttle.io_service_bytes",
+			entry: &stats.Blkio.IoServiceBytesRecursive,
+		},
+	}
+	if settings == nil {
+		settings = throttle
 	}
 	f, err := os.Open("/proc/diskstats")
 	if err != nil {
@@ -137,6 +175,16 @@ func (b *blkioController) Stat(path string, stats *Metrics) error {
 			return err
 		}
 	}
+	// the recursive files exist but stay empty when the devices use a
+	// scheduler other than CFQ or BFQ, such as none or mq-deadline on NVMe
+	if stats.Blkio.Hierarchical && len(stats.Blkio.IoServicedRecursive) == 0 && len(stats.Blkio.IoServiceBytesRecursive) == 0 {
+		stats.Blkio.Hierarchical = false
+		for _, t := range throttle {
+			if err := b.readEntry(devices, path, t.name, t.entry); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -289,6 +337,35 @@ func throttleddev(v interface{}) []byte {
 	return []byte(fmt.Sprintf("%d:%d %d", td.Major, td.Minor, td.Rate))
 }
 
+// NewThrottleDevice returns a throttling entry limiting the block device at
+// the provided path to rate, resolving the device's major and minor numbers.
+// The rate is in bytes or io operations per second depending on the list the
+// entry is added to in specs.LinuxBlockIO.
+func NewThrottleDevice(path string, rate uint64) (specs.LinuxThrottleDevice, error) {
+	var td specs.LinuxThrottleDevice
+	major, minor, err := blockDeviceNumbers(path)
+	if err != nil {
+		return td, err
+	}
+	td.Major = major
+	td.Minor = minor
+	td.Rate = rate
+	return td, nil
+}
+
+// blockDeviceNumbers returns the major and minor numbers of the block device
+// at the provided path
+func blockDeviceNumbers(path string) (int64, int64, error) {
+	var st unix.Stat_t
+	if err := unix.Stat(path, &st); err != nil {
+		return 0, 0, err
+	}
+	if st.Mode&unix.S_IFMT != unix.S_IFBLK {
+		return 0, 0, fmt.Errorf("cgroups: %q is not a block device", path)
+	}
+	return int64(major(uint64(st.Rdev))), int64(minor(uint64(st.Rdev))), nil
+}
+
 func splitBlkIOStatLine(r rune) bool {
 	return r == ' ' || r == ':'
 }
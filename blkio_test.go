@@ -17,8 +17,13 @@
 package cgroups
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 const data = `   7       0 loop0 0 0 0 0 0 0 0 0 0 0 0
@@ -49,3 +54,193 @@ func TestGetDevices(t *testing.T) {
 		t.Fatalf("expected device name %q but received %q", expected, name)
 	}
 }
+
+func TestThrottleDeviceNotBlock(t *testing.T) {
+	if _, err := NewThrottleDevice("/dev/null", 1024); err == nil {
+		t.Fatal("expected error for a character device")
+	}
+}
+
+func TestDeviceNumbers(t *testing.T) {
+	for _, tt := range []struct {
+		dev          uint64
+		major, minor uint64
+	}{
+		{
+			dev:   0x810,
+			major: 8,
+			minor: 16,
+		},
+		{
+			// minors above 255 are stored in the upper bits
+			dev:   0x100803,
+			major: 8,
+			minor: 259,
+		},
+	} {
+		if m := major(tt.dev); m != tt.major {
+			t.Errorf("expected major %d but received %d", tt.major, m)
+		}
+		if m := minor(tt.dev); m != tt.minor {
+			t.Errorf("expected minor %d but received %d", tt.minor, m)
+		}
+	}
+}
+
+func TestBlkioBFQWeight(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	blkio := NewBlkio(mock.root)
+	if err := os.MkdirAll(blkio.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	bfq := filepath.Join(blkio.Path("test"), "blkio.bfq.weight")
+	if err := ioutil.WriteFile(bfq, nil, defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	weight := uint16(500)
+	if err := blkio.Create("test", &specs.LinuxResources{
+		BlockIO: &specs.LinuxBlockIO{
+			Weight: &weight,
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	v, err := readUint(bfq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != uint64(weight) {
+		t.Fatalf("expected bfq weight %d but received %d", weight, v)
+	}
+	if _, err := os.Lstat(filepath.Join(blkio.Path("test"), "blkio.weight")); !os.IsNotExist(err) {
+		t.Fatal("expected blkio.weight to not be written")
+	}
+}
+
+func TestBlkioStatBFQ(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	blkio := NewBlkio(mock.root)
+	if err := os.MkdirAll(blkio.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []struct {
+		name  string
+		value string
+	}{
+		{
+			name:  "blkio.bfq.io_service_bytes_recursive",
+			value: "8:0 Read 4096\n8:0 Write 8192\nTotal 12288\n",
+		},
+		{
+			name:  "blkio.bfq.io_serviced_recursive",
+			value: "8:0 Read 1\n8:0 Write 2\nTotal 3\n",
+		},
+		{
+			name:  "blkio.throttle.io_serviced",
+			value: "8:0 Read 1\n8:0 Write 2\nTotal 3\n",
+		},
+	} {
+		if err := ioutil.WriteFile(filepath.Join(blkio.Path("test"), v.name), []byte(v.value), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var metrics Metrics
+	if err := blkio.Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	if !metrics.Blkio.Hierarchical {
+		t.Fatal("expected bfq stats to be hierarchical")
+	}
+	if l := len(metrics.Blkio.IoServicedRecursive); l != 2 {
+		t.Fatalf("expected 2 serviced entries but received %d", l)
+	}
+	e := metrics.Blkio.IoServiceBytesRecursive[1]
+	if e.Major != 8 || e.Minor != 0 || e.Op != "Write" || e.Value != 8192 {
+		t.Fatalf("unexpected service bytes entry %+v", e)
+	}
+}
+
+func TestBlkioStatThrottle(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	blkio := NewBlkio(mock.root)
+	if err := os.MkdirAll(blkio.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{
+		"blkio.throttle.io_serviced",
+		"blkio.throttle.io_service_bytes",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(blkio.Path("test"), name), []byte("8:0 Read 1\nTotal 1\n"), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var metrics Metrics
+	if err := blkio.Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	if metrics.Blkio.Hierarchical {
+		t.Fatal("expected throttle stats to not be hierarchical")
+	}
+	if l := len(metrics.Blkio.IoServicedRecursive); l != 1 {
+		t.Fatalf("expected 1 serviced entry but received %d", l)
+	}
+}
+
+func TestBlkioStatEmptyRecursive(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	blkio := NewBlkio(mock.root)
+	if err := os.MkdirAll(blkio.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{
+		"blkio.sectors_recursive",
+		"blkio.io_service_bytes_recursive",
+		"blkio.io_serviced_recursive",
+		"blkio.io_queued_recursive",
+		"blkio.io_service_time_recursive",
+		"blkio.io_wait_time_recursive",
+		"blkio.io_merged_recursive",
+		"blkio.time_recursive",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(blkio.Path("test"), name), []byte("Total 0\n"), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range []string{
+		"blkio.throttle.io_serviced",
+		"blkio.throttle.io_service_bytes",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(blkio.Path("test"), name), []byte("259:0 Read 1\nTotal 1\n"), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var metrics Metrics
+	if err := blkio.Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	if metrics.Blkio.Hierarchical {
+		t.Fatal("expected throttle stats to not be hierarchical")
+	}
+	if l := len(metrics.Blkio.IoServicedRecursive); l != 1 {
+		t.Fatalf("expected 1 serviced entry but received %d", l)
+	}
+	if e := metrics.Blkio.IoServiceBytesRecursive[0]; e.Major != 259 || e.Value != 1 {
+		t.Fatalf("unexpected service bytes entry %+v", e)
+	}
+}
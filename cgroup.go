@@ -17,16 +17,20 @@
 package cgroups
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"strconv"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
 )
 
 // New returns a new control via the cgroup cgroups interface
@@ -41,10 +45,49 @@ func New(hierarchy Hierarchy, path Path, resources *specs.LinuxResources, opts .
 	if err != nil {
 		return nil, err
 	}
-	var active []Subsystem
+	if subsystems, err = filterSubsystems(subsystems, config.Controllers); err != nil {
+		return nil, err
+	}
+	if config.ExistOK {
+		existing, err := existingSubsystems(subsystems, path, config.Ownership)
+		if err != nil {
+			return nil, err
+		}
+		if len(existing) > 0 {
+			l, err := Load(hierarchy, path, opts...)
+			if err != nil {
+				return nil, err
+			}
+			c := l.(*cgroup)
+			// the cgroup may have been removed from a subsystem since it
+			// was checked
+			for _, name := range existing {
+				if c.getSubsystem(name) == nil {
+					return nil, ErrCgroupIncomplete
+				}
+			}
+			if resources != nil {
+				if err := c.Update(resources); err != nil {
+					return nil, err
+				}
+			}
+			if err := c.addProcesses(config.Processes); err != nil {
+				return nil, err
+			}
+			return c, nil
+		}
+	}
+	var (
+		active  []Subsystem
+		skipped []Name
+	)
 	for _, s := range subsystems {
 		// check if subsystem exists
-		if err := initializeSubsystem(s, path, resources); err != nil {
+		err := ErrControllerNotActive
+		if !config.SkipUnmounted || isMounted(s) {
+			err = initializeSubsystem(s, path, resources)
+		}
+		if err != nil {
 			if err == ErrControllerNotActive {
 				if config.InitCheck != nil {
 					if skerr := config.InitCheck(s, path, err); skerr != nil {
@@ -53,16 +96,76 @@ func New(hierarchy Hierarchy, path Path, resources *specs.LinuxResources, opts .
 						}
 					}
 				}
+				skipped = append(skipped, s.Name())
 				continue
 			}
 			return nil, err
 		}
 		active = append(active, s)
+		if s.Name() == Cpuset && config.CpusetCloneChildren {
+			p, err := path(Cpuset)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.(*cpusetController).SetCloneChildren(p, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := chownSubsystems(active, path, config.Ownership); err != nil {
+		return nil, err
+	}
+	c := &cgroup{
+		path:          path,
+		subsystems:    active,
+		skipped:       skipped,
+		freezeTimeout: config.FreezeTimeout,
+		ownership:     config.Ownership,
+		verifyAttach:  config.VerifyAttach,
+		applied:       appliedResources(active, resources),
+	}
+	if err := c.addProcesses(config.Processes); err != nil {
+		// the processes were moved back, remove the new cgroup so that
+		// the creation can be retried
+		c.Delete()
+		return nil, err
+	}
+	return c, nil
+}
+
+// addProcesses adds the processes requested by WithProcesses to the cgroup.
+// Each process is written to every subsystem in turn so the move is not atomic,
+// if one of them cannot be added the processes that were already moved are
+// moved back to the cgroups they were in before.
+func (c *cgroup) addProcesses(processes []Process) error {
+	origins := make([]map[string]string, len(processes))
+	for i, p := range processes {
+		// a process that cannot be inspected is not moved back
+		origins[i], _ = parseCgroupFile(fmt.Sprintf("/proc/%d/cgroup", p.Pid))
+		if err := c.Add(p); err != nil {
+			c.restoreProcesses(processes[:i+1], origins)
+			return errors.Wrapf(err, "add process %d", p.Pid)
+		}
+	}
+	return nil
+}
+
+// restoreProcesses moves the processes back to the cgroups listed in their
+// origins, failures are ignored as the processes may have exited
+func (c *cgroup) restoreProcesses(processes []Process, origins []map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, p := range processes {
+		for _, s := range pathers(c.subsystems) {
+			origin, ok := origins[i][string(s.Name())]
+			if !ok {
+				if origin, ok = origins[i][fmt.Sprintf("name=%s", s.Name())]; !ok {
+					continue
+				}
+			}
+			writePid(filepath.Join(s.Path(origin), cgroupProcs), p.Pid)
+		}
 	}
-	return &cgroup{
-		path:       path,
-		subsystems: active,
-	}, nil
 }
 
 // Load will load an existing cgroup and allow it to be controlled
@@ -73,11 +176,17 @@ func Load(hierarchy Hierarchy, path Path, opts ...InitOpts) (Cgroup, error) {
 			return nil, err
 		}
 	}
-	var activeSubsystems []Subsystem
+	var (
+		activeSubsystems []Subsystem
+		skipped          []Name
+	)
 	subsystems, err := hierarchy()
 	if err != nil {
 		return nil, err
 	}
+	if subsystems, err = filterSubsystems(subsystems, config.Controllers); err != nil {
+		return nil, err
+	}
 	// check that the subsystems still exist, and keep only those that actually exist
 	for _, s := range pathers(subsystems) {
 		p, err := path(s.Name())
@@ -93,12 +202,14 @@ func Load(hierarchy Hierarchy, path Path, opts ...InitOpts) (Cgroup, error) {
 						}
 					}
 				}
+				skipped = append(skipped, s.Name())
 				continue
 			}
 			return nil, err
 		}
 		if _, err := os.Lstat(s.Path(p)); err != nil {
 			if os.IsNotExist(err) {
+				skipped = append(skipped, s.Name())
 				continue
 			}
 			return nil, err
@@ -110,17 +221,29 @@ func Load(hierarchy Hierarchy, path Path, opts ...InitOpts) (Cgroup, error) {
 		return nil, ErrCgroupDeleted
 	}
 	return &cgroup{
-		path:       path,
-		subsystems: activeSubsystems,
+		path:          path,
+		subsystems:    activeSubsystems,
+		skipped:       skipped,
+		freezeTimeout: config.FreezeTimeout,
+		ownership:     config.Ownership,
+		verifyAttach:  config.VerifyAttach,
 	}, nil
 }
 
 type cgroup struct {
 	path Path
 
-	subsystems []Subsystem
-	mu         sync.Mutex
-	err        error
+	subsystems    []Subsystem
+	skipped       []Name
+	freezeTimeout time.Duration
+	ownership     *Ownership
+	verifyAttach  bool
+	// applied holds the encoded resources last written to each subsystem
+	applied map[Name][]byte
+	// events stop the memory event notifications registered for the cgroup
+	events []func()
+	mu     sync.Mutex
+	err    error
 }
 
 // New returns a new sub cgroup
@@ -136,9 +259,16 @@ func (c *cgroup) New(name string, resources *specs.LinuxResources) (Cgroup, erro
 			return nil, err
 		}
 	}
+	if err := chownSubsystems(c.subsystems, path, c.ownership); err != nil {
+		return nil, err
+	}
 	return &cgroup{
-		path:       path,
-		subsystems: c.subsystems,
+		path:          path,
+		subsystems:    c.subsystems,
+		freezeTimeout: c.freezeTimeout,
+		ownership:     c.ownership,
+		verifyAttach:  c.verifyAttach,
+		applied:       appliedResources(c.subsystems, resources),
 	}, nil
 }
 
@@ -148,8 +278,17 @@ func (c *cgroup) Subsystems() []Subsystem {
 	return c.subsystems
 }
 
-// Add moves the provided process into the new cgroup
-func (c *cgroup) Add(process Process) error {
+// Skipped returns the subsystems of the hierarchy that were skipped when the
+// cgroup was created or loaded because they are not mounted or active
+func (c *cgroup) Skipped() []Name {
+	return c.skipped
+}
+
+// Add moves the provided process into the new cgroup, when subsystems are
+// provided the process is only moved in those subsystems. ErrProcessNotExist
+// is returned if the process does not exist and ErrFrozen if the cgroup is
+// frozen.
+func (c *cgroup) Add(process Process, subsystems ...Name) error {
 	if process.Pid <= 0 {
 		return ErrInvalidPid
 	}
@@ -158,28 +297,45 @@ func (c *cgroup) Add(process Process) error {
 	if c.err != nil {
 		return c.err
 	}
-	return c.add(process)
+	if err := c.checkFrozen(); err != nil {
+		return err
+	}
+	if err := c.addPid(cgroupProcs, process.Pid, subsystems); err != nil {
+		return err
+	}
+	if c.verifyAttach {
+		return c.verifyPid(process.Pid, subsystems)
+	}
+	return nil
 }
 
-func (c *cgroup) add(process Process) error {
+// verifyPid checks that /proc/<pid>/cgroup lists the cgroup for the
+// subsystems the process was added to
+func (c *cgroup) verifyPid(pid int, subsystems []Name) error {
+	paths, err := parseCgroupFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrProcessNotExist
+		}
+		return err
+	}
+	expected := make(map[Name]string)
 	for _, s := range pathers(c.subsystems) {
+		if len(subsystems) > 0 && !containsName(subsystems, s.Name()) {
+			continue
+		}
 		p, err := c.path(s.Name())
 		if err != nil {
 			return err
 		}
-		if err := ioutil.WriteFile(
-			filepath.Join(s.Path(p), cgroupProcs),
-			[]byte(strconv.Itoa(process.Pid)),
-			defaultFilePerm,
-		); err != nil {
-			return err
-		}
+		expected[s.Name()] = p
 	}
-	return nil
+	return verifyMembership(paths, expected)
 }
 
-// AddTask moves the provided tasks (threads) into the new cgroup
-func (c *cgroup) AddTask(process Process) error {
+// AddTask moves the provided tasks (threads) into the new cgroup, when
+// subsystems are provided the task is only moved in those subsystems
+func (c *cgroup) AddTask(process Process, subsystems ...Name) error {
 	if process.Pid <= 0 {
 		return ErrInvalidPid
 	}
@@ -188,33 +344,44 @@ func (c *cgroup) AddTask(process Process) error {
 	if c.err != nil {
 		return c.err
 	}
-	return c.addTask(process)
+	return c.addPid(cgroupTasks, process.Pid, subsystems)
 }
 
-func (c *cgroup) addTask(process Process) error {
+func (c *cgroup) addPid(file string, pid int, subsystems []Name) error {
 	for _, s := range pathers(c.subsystems) {
+		if len(subsystems) > 0 && !containsName(subsystems, s.Name()) {
+			continue
+		}
 		p, err := c.path(s.Name())
 		if err != nil {
 			return err
 		}
-		if err := ioutil.WriteFile(
-			filepath.Join(s.Path(p), cgroupTasks),
-			[]byte(strconv.Itoa(process.Pid)),
-			defaultFilePerm,
-		); err != nil {
+		if err := writePid(filepath.Join(s.Path(p), file), pid); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// Delete will remove the control group from each of the subsystems registered
-func (c *cgroup) Delete() error {
+// Delete will remove the control group and its children from each of the
+// subsystems registered. Removal is retried while processes are still exiting.
+func (c *cgroup) Delete(opts ...DeleteOpts) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.err != nil {
 		return c.err
 	}
+	var config DeleteConfig
+	for _, o := range opts {
+		if err := o(&config); err != nil {
+			return err
+		}
+	}
+	if config.Kill || config.MoveToParent {
+		if err := c.evacuate(config.Kill); err != nil {
+			return err
+		}
+	}
 	var errors []string
 	for _, s := range c.subsystems {
 		if d, ok := s.(deleter); ok {
@@ -241,10 +408,76 @@ func (c *cgroup) Delete() error {
 	if len(errors) > 0 {
 		return fmt.Errorf("cgroups: unable to remove paths %s", strings.Join(errors, ", "))
 	}
+	for _, stop := range c.events {
+		stop()
+	}
+	c.events = nil
 	c.err = ErrCgroupDeleted
 	return nil
 }
 
+// checkFrozen returns ErrFrozen if the cgroup is frozen or being frozen
+func (c *cgroup) checkFrozen() error {
+	s := c.getSubsystem(Freezer)
+	if s == nil {
+		return nil
+	}
+	sp, err := c.path(Freezer)
+	if err != nil {
+		return err
+	}
+	state, err := s.(*freezerController).state(sp)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if state == Frozen || state == Freezing {
+		return ErrFrozen
+	}
+	return nil
+}
+
+// evacuate kills the processes remaining in the cgroup or moves them to the
+// parent cgroup
+func (c *cgroup) evacuate(kill bool) error {
+	// killed processes cannot exit until the cgroup is thawed
+	if kill {
+		if err := c.checkFrozen(); err != nil {
+			return err
+		}
+	}
+	killed := make(map[int]struct{})
+	for _, s := range pathers(c.subsystems) {
+		processes, err := c.processes(s.Name(), true)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		for _, p := range processes {
+			if kill {
+				if _, ok := killed[p.Pid]; ok {
+					continue
+				}
+				killed[p.Pid] = struct{}{}
+				if err := unix.Kill(p.Pid, unix.SIGKILL); err != nil && err != unix.ESRCH {
+					return err
+				}
+				continue
+			}
+			sp, err := c.path(s.Name())
+			if err != nil {
+				return err
+			}
+			parent := filepath.Dir(s.Path(sp))
+			if err := writePid(filepath.Join(parent, cgroupProcs), p.Pid); err != nil && err != ErrProcessNotExist {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // Stat returns the current metrics for the cgroup
 func (c *cgroup) Stat(handlers ...ErrorHandler) (*Metrics, error) {
 	c.mu.Lock()
@@ -292,31 +525,61 @@ func (c *cgroup) Stat(handlers ...ErrorHandler) (*Metrics, error) {
 	return stats, nil
 }
 
-// Update updates the cgroup with the new resource values provided
+// Update updates the cgroup with the new resource values provided.
+// Subsystems whose resources did not change since they were last written by
+// this cgroup are skipped, unless their files were written since then or no
+// longer match the resources. Subsystems whose files cannot be compared, such
+// as blkio, rdma and devices, are always rewritten.
 //
 // Be prepared to handle EBUSY when trying to update a cgroup with
 // live processes and other operations like Stats being performed at the
-// same time
+// same time. Frozen cgroups, such as paused containers, can be updated.
 func (c *cgroup) Update(resources *specs.LinuxResources) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.err != nil {
 		return c.err
 	}
+	if c.applied == nil {
+		c.applied = make(map[Name][]byte)
+	}
 	for _, s := range c.subsystems {
 		if u, ok := s.(updater); ok {
 			sp, err := c.path(s.Name())
 			if err != nil {
 				return err
 			}
+			key := resourcesKey(s.Name(), resources)
+			if key != nil && bytes.Equal(c.applied[s.Name()], key) && !c.drifted(s, sp, resources) {
+				continue
+			}
 			if err := u.Update(sp, resources); err != nil {
 				return err
 			}
+			if key != nil {
+				c.applied[s.Name()] = key
+			}
 		}
 	}
 	return nil
 }
 
+// drifted returns true if the files of the subsystem no longer match the
+// resources, such as after they were written outside of this cgroup.
+// Subsystems that cannot compare their files, such as blkio, rdma and devices,
+// are always reported as drifted so that they are rewritten.
+func (c *cgroup) drifted(s Subsystem, path string, resources *specs.LinuxResources) bool {
+	d, ok := s.(differ)
+	if !ok {
+		return true
+	}
+	if resources == nil {
+		return false
+	}
+	changes, err := d.Diff(path, resources)
+	return err != nil || len(changes) > 0
+}
+
 // Processes returns the processes running inside the cgroup along
 // with the subsystem used, pid, and path
 func (c *cgroup) Processes(subsystem Name, recursive bool) ([]Process, error) {
@@ -330,6 +593,9 @@ func (c *cgroup) Processes(subsystem Name, recursive bool) ([]Process, error) {
 
 func (c *cgroup) processes(subsystem Name, recursive bool) ([]Process, error) {
 	s := c.getSubsystem(subsystem)
+	if s == nil {
+		return nil, ErrControllerNotActive
+	}
 	sp, err := c.path(subsystem)
 	if err != nil {
 		return nil, err
@@ -373,6 +639,9 @@ func (c *cgroup) Tasks(subsystem Name, recursive bool) ([]Task, error) {
 
 func (c *cgroup) tasks(subsystem Name, recursive bool) ([]Task, error) {
 	s := c.getSubsystem(subsystem)
+	if s == nil {
+		return nil, ErrControllerNotActive
+	}
 	sp, err := c.path(subsystem)
 	if err != nil {
 		return nil, err
@@ -418,7 +687,28 @@ func (c *cgroup) Freeze() error {
 	if err != nil {
 		return err
 	}
-	return s.(*freezerController).Freeze(sp)
+	return s.(*freezerController).FreezeTimeout(sp, c.freezeTimeout)
+}
+
+// FreezeContext freezes the entire cgroup and all the processes inside it.
+// If the cgroup is not frozen before the context is done it is thawed again
+// and a *FreezeError listing the tasks stuck in uninterruptible sleep is
+// returned.
+func (c *cgroup) FreezeContext(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	s := c.getSubsystem(Freezer)
+	if s == nil {
+		return ErrFreezerNotSupported
+	}
+	sp, err := c.path(Freezer)
+	if err != nil {
+		return err
+	}
+	return s.(*freezerController).FreezeContext(ctx, sp)
 }
 
 // Thaw thaws out the cgroup and all the processes inside it
@@ -436,7 +726,48 @@ func (c *cgroup) Thaw() error {
 	if err != nil {
 		return err
 	}
-	return s.(*freezerController).Thaw(sp)
+	return s.(*freezerController).ThawTimeout(sp, c.freezeTimeout)
+}
+
+// SetCpusetExclusive toggles the exclusive use of the cgroup's cpus and
+// memory nodes. Returns ErrCpusetNotSupported if cpuset cgroups is not
+// supported.
+func (c *cgroup) SetCpusetExclusive(cpus, mems bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	s := c.getSubsystem(Cpuset)
+	if s == nil {
+		return ErrCpusetNotSupported
+	}
+	sp, err := c.path(Cpuset)
+	if err != nil {
+		return err
+	}
+	delete(c.applied, Cpuset)
+	return s.(*cpusetController).SetExclusive(sp, cpus, mems)
+}
+
+// CpusetPlacement returns the effective cpus and memory nodes the kernel
+// computed for the cgroup. Returns ErrCpusetNotSupported if cpuset cgroups
+// is not supported.
+func (c *cgroup) CpusetPlacement() (*CpusetPlacement, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	s := c.getSubsystem(Cpuset)
+	if s == nil {
+		return nil, ErrCpusetNotSupported
+	}
+	sp, err := c.path(Cpuset)
+	if err != nil {
+		return nil, err
+	}
+	return s.(*cpusetController).Placement(sp)
 }
 
 // OOMEventFD returns the memory cgroup's out of memory event fd that triggers
@@ -459,6 +790,442 @@ func (c *cgroup) OOMEventFD() (uintptr, error) {
 	return s.(*memoryController).OOMEventFD(sp)
 }
 
+// RegisterMemoryOOMEvent returns a channel that receives a notification each
+// time processes inside the cgroup receive an oom event. The channel is closed
+// once the cgroup is removed. Returns ErrMemoryNotSupported if memory cgroups
+// is not supported.
+func (c *cgroup) RegisterMemoryOOMEvent() (<-chan struct{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	s := c.getSubsystem(Memory)
+	if s == nil {
+		return nil, ErrMemoryNotSupported
+	}
+	sp, err := c.path(Memory)
+	if err != nil {
+		return nil, err
+	}
+	return c.trackEvent(s.(*memoryController).RegisterOOMEvent(sp))
+}
+
+// trackEvent keeps the stop func of an event registration so that the
+// notifications are stopped when the cgroup is deleted
+func (c *cgroup) trackEvent(ch <-chan struct{}, stop func(), err error) (<-chan struct{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	c.events = append(c.events, stop)
+	return ch, nil
+}
+
+// RegisterMemoryPressureEvent returns a channel that receives a notification
+// each time the cgroup reaches the provided memory pressure level. The channel
+// is closed once the cgroup is removed. Returns ErrMemoryNotSupported if memory
+// cgroups is not supported and ErrInvalidPressureEvent for an unknown level or
+// mode.
+func (c *cgroup) RegisterMemoryPressureEvent(level MemoryPressureLevel, mode EventNotificationMode) (<-chan struct{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	s := c.getSubsystem(Memory)
+	if s == nil {
+		return nil, ErrMemoryNotSupported
+	}
+	sp, err := c.path(Memory)
+	if err != nil {
+		return nil, err
+	}
+	return c.trackEvent(s.(*memoryController).RegisterPressureEvent(sp, level, mode))
+}
+
+// RegisterMemoryThreshold returns a channel that receives a notification each
+// time the cgroup's memory usage crosses the provided threshold in bytes. If
+// swap is true the memory+swap usage is used. The channel is closed once the
+// cgroup is removed. Returns ErrMemoryNotSupported if memory cgroups is not
+// supported.
+func (c *cgroup) RegisterMemoryThreshold(threshold uint64, swap bool) (<-chan struct{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	s := c.getSubsystem(Memory)
+	if s == nil {
+		return nil, ErrMemoryNotSupported
+	}
+	sp, err := c.path(Memory)
+	if err != nil {
+		return nil, err
+	}
+	return c.trackEvent(s.(*memoryController).RegisterThresholdEvent(sp, threshold, swap))
+}
+
+// RegisterMemoryEvent returns a channel that receives a notification each
+// time the event registered for the memory file with the provided arguments
+// fires, for event files without a dedicated helper. The channel is closed
+// once the cgroup is removed. Returns ErrMemoryNotSupported if memory cgroups
+// is not supported.
+func (c *cgroup) RegisterMemoryEvent(file, args string) (<-chan struct{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	s := c.getSubsystem(Memory)
+	if s == nil {
+		return nil, ErrMemoryNotSupported
+	}
+	sp, err := c.path(Memory)
+	if err != nil {
+		return nil, err
+	}
+	return c.trackEvent(s.(*memoryController).RegisterEvent(sp, file, args))
+}
+
+// SetMemoryOOMKillDisable toggles the oom killer for the cgroup. Returns
+// ErrMemoryNotSupported if memory cgroups is not supported.
+func (c *cgroup) SetMemoryOOMKillDisable(disable bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	s := c.getSubsystem(Memory)
+	if s == nil {
+		return ErrMemoryNotSupported
+	}
+	sp, err := c.path(Memory)
+	if err != nil {
+		return err
+	}
+	delete(c.applied, Memory)
+	return s.(*memoryController).SetOOMKillDisable(sp, disable)
+}
+
+// RegisterPidsMaxEvent returns a channel that receives the updated count of
+// forks that failed because the cgroup's pids limit was reached. The channel
+// is closed once the cgroup is removed. Returns ErrPidsNotSupported if pids
+// cgroups is not supported.
+func (c *cgroup) RegisterPidsMaxEvent() (<-chan uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	s := c.getSubsystem(Pids)
+	if s == nil {
+		return nil, ErrPidsNotSupported
+	}
+	sp, err := c.path(Pids)
+	if err != nil {
+		return nil, err
+	}
+	return s.(*pidsController).RegisterMaxEvent(sp)
+}
+
+// AddDeviceRules writes the device rules to the cgroup's devices.allow or
+// devices.deny files, nothing is written if one of the rules is invalid
+func (c *cgroup) AddDeviceRules(rules ...DeviceRule) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	s := c.getSubsystem(Devices)
+	if s == nil {
+		return ErrDevicesNotSupported
+	}
+	sp, err := c.path(Devices)
+	if err != nil {
+		return err
+	}
+	delete(c.applied, Devices)
+	for _, rule := range rules {
+		if err := s.(*devicesController).AddRule(sp, rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeviceRules returns the device access currently allowed for the cgroup
+func (c *cgroup) DeviceRules() ([]DeviceRule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	s := c.getSubsystem(Devices)
+	if s == nil {
+		return nil, ErrDevicesNotSupported
+	}
+	sp, err := c.path(Devices)
+	if err != nil {
+		return nil, err
+	}
+	return s.(*devicesController).Rules(sp)
+}
+
+// SetMiscLimits writes the maximum usage of each misc resource, such as the
+// "sev" ASIDs. Returns ErrMiscNotSupported if misc cgroups is not supported.
+func (c *cgroup) SetMiscLimits(limits map[string]uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	s := c.getSubsystem(Misc)
+	if s == nil {
+		return ErrMiscNotSupported
+	}
+	sp, err := c.path(Misc)
+	if err != nil {
+		return err
+	}
+	delete(c.applied, Misc)
+	return s.(*miscController).SetLimits(sp, limits)
+}
+
+// NetClassID returns the net_cls.classid tagged on the packets sent from the
+// cgroup. Returns ErrNetClsNotSupported if net_cls cgroups is not supported.
+func (c *cgroup) NetClassID() (uint32, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return 0, c.err
+	}
+	s := c.getSubsystem(NetCLS)
+	if s == nil {
+		return 0, ErrNetClsNotSupported
+	}
+	sp, err := c.path(NetCLS)
+	if err != nil {
+		return 0, err
+	}
+	return s.(*netclsController).ClassID(sp)
+}
+
+// NetPriorities returns the priority of each interface from the
+// net_prio.ifpriomap of the cgroup. Returns ErrNetPrioNotSupported if net_prio
+// cgroups is not supported.
+func (c *cgroup) NetPriorities() (map[string]uint32, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	s := c.getSubsystem(NetPrio)
+	if s == nil {
+		return nil, ErrNetPrioNotSupported
+	}
+	sp, err := c.path(NetPrio)
+	if err != nil {
+		return nil, err
+	}
+	return s.(*netprioController).Priorities(sp)
+}
+
+// SetNetPriorities sets the priority of each interface in the
+// net_prio.ifpriomap of the cgroup, every interface must exist in the current
+// network namespace. Returns ErrNetPrioNotSupported if net_prio cgroups is not
+// supported.
+func (c *cgroup) SetNetPriorities(priorities map[string]uint32) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	s := c.getSubsystem(NetPrio)
+	if s == nil {
+		return ErrNetPrioNotSupported
+	}
+	sp, err := c.path(NetPrio)
+	if err != nil {
+		return err
+	}
+	delete(c.applied, NetPrio)
+	return s.(*netprioController).SetPriorities(sp, priorities)
+}
+
+// SetNotifyOnRelease toggles notify_on_release in every subsystem of the
+// cgroup so that the hierarchy's release agent is run once the cgroup
+// becomes empty
+func (c *cgroup) SetNotifyOnRelease(enabled bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	v := "0"
+	if enabled {
+		v = "1"
+	}
+	for _, s := range pathers(c.subsystems) {
+		p, err := c.path(s.Name())
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(
+			filepath.Join(s.Path(p), "notify_on_release"),
+			[]byte(v),
+			defaultFilePerm,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadFile returns the content of the subsystem's file for the cgroup, such
+// as ReadFile(Memory, "memory.stat"), for files without a dedicated helper
+func (c *cgroup) ReadFile(subsystem Name, file string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, err := c.filePath(subsystem, file)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(p)
+}
+
+// WriteFile writes the value to the subsystem's file for the cgroup, such as
+// WriteFile(Memory, "memory.swappiness", []byte("0")), for files without a
+// dedicated helper
+func (c *cgroup) WriteFile(subsystem Name, file string, value []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	p, err := c.filePath(subsystem, file)
+	if err != nil {
+		return err
+	}
+	delete(c.applied, subsystem)
+	return ioutil.WriteFile(p, value, defaultFilePerm)
+}
+
+// filePath returns the path of the file inside the cgroup directory of the
+// subsystem
+func (c *cgroup) filePath(subsystem Name, file string) (string, error) {
+	if c.err != nil {
+		return "", c.err
+	}
+	if file == "" || file != filepath.Base(file) || file == "." || file == ".." {
+		return "", ErrInvalidFile
+	}
+	s := c.getSubsystem(subsystem)
+	if s == nil {
+		return "", ErrControllerNotActive
+	}
+	p, ok := s.(pather)
+	if !ok {
+		return "", ErrControllerNotActive
+	}
+	sp, err := c.path(subsystem)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(p.Path(sp), file), nil
+}
+
+// Snapshot reads the tunable files of every subsystem of the cgroup
+func (c *cgroup) Snapshot() (*Snapshot, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	snapshot := &Snapshot{
+		Files: make(map[Name]map[string]string),
+	}
+	for _, s := range pathers(c.subsystems) {
+		sp, err := c.path(s.Name())
+		if err != nil {
+			return nil, err
+		}
+		files, err := readTunables(s.Path(sp))
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Files[s.Name()] = files
+	}
+	return snapshot, nil
+}
+
+// Restore writes the tunable files captured by Snapshot to the cgroup, the
+// cpuset placement is restored first. Subsystems and files that do not exist
+// for the cgroup are skipped.
+func (c *cgroup) Restore(snapshot *Snapshot) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	subsystems := pathers(c.subsystems)
+	sort.SliceStable(subsystems, func(i, j int) bool {
+		return subsystems[i].Name() == Cpuset && subsystems[j].Name() != Cpuset
+	})
+	for _, s := range subsystems {
+		files, ok := snapshot.Files[s.Name()]
+		if !ok {
+			continue
+		}
+		sp, err := c.path(s.Name())
+		if err != nil {
+			return err
+		}
+		if err := writeTunables(s.Path(sp), files); err != nil {
+			return err
+		}
+	}
+	// the resources applied by Update no longer match the files
+	c.applied = nil
+	return nil
+}
+
+// Diff compares the settings of the cgroup with the resources and returns
+// the changes Update would make, without applying them. Only the cpu, cpuset,
+// memory, hugetlb, pids, net_cls and net_prio settings are compared. The blkio,
+// rdma and devices settings are not, their files are keyed by device and are
+// read back in a different format than they are written, and the freezer,
+// cpuacct, perf_event, misc and named subsystems have no settings in the
+// resources. Nil resources have no changes.
+func (c *cgroup) Diff(resources *specs.LinuxResources) ([]Change, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	if resources == nil {
+		return nil, nil
+	}
+	var changes []Change
+	for _, s := range c.subsystems {
+		d, ok := s.(differ)
+		if !ok {
+			continue
+		}
+		sp, err := c.path(s.Name())
+		if err != nil {
+			return nil, err
+		}
+		sc, err := d.Diff(sp, resources)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, sc...)
+	}
+	return changes, nil
+}
+
 // State returns the state of the cgroup and its processes
 func (c *cgroup) State() State {
 	c.mu.Lock()
@@ -482,25 +1249,96 @@ func (c *cgroup) State() State {
 	return state
 }
 
+// Walk calls fn for each cgroup below the cgroup, parents before their
+// children, with the path relative to the cgroup. Returning filepath.SkipDir
+// from fn skips the children of that cgroup. Cgroups that are removed while
+// walking are skipped.
+func (c *cgroup) Walk(fn func(path string, cg Cgroup) error) error {
+	root, err := c.walkRoot()
+	if err != nil || root == "" {
+		return err
+	}
+	return filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() || p == root {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		return fn(rel, &cgroup{
+			path:          subPath(c.path, rel),
+			subsystems:    c.subsystems,
+			freezeTimeout: c.freezeTimeout,
+			ownership:     c.ownership,
+			verifyAttach:  c.verifyAttach,
+		})
+	})
+}
+
+// walkRoot returns the directory of the first active subsystem that is used
+// to discover the child cgroups
+func (c *cgroup) walkRoot() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return "", c.err
+	}
+	for _, s := range pathers(c.subsystems) {
+		p, err := c.path(s.Name())
+		if err != nil {
+			if err == ErrControllerNotActive {
+				continue
+			}
+			return "", err
+		}
+		return s.Path(p), nil
+	}
+	return "", nil
+}
+
 // MoveTo does a recursive move subsystem by subsystem of all the processes
-// inside the group
+// inside the group. Processes that exit while being moved are skipped, the
+// ones that could not be moved are reported in a *MoveError. ErrFrozen is
+// returned if either cgroup is frozen.
 func (c *cgroup) MoveTo(destination Cgroup) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.err != nil {
 		return c.err
 	}
-	for _, s := range c.subsystems {
+	if err := c.checkFrozen(); err != nil {
+		return err
+	}
+	if state := destination.State(); state == Frozen || state == Freezing {
+		return ErrFrozen
+	}
+	failed := make(map[int]error)
+	for _, s := range pathers(c.subsystems) {
 		processes, err := c.processes(s.Name(), true)
 		if err != nil {
 			return err
 		}
 		for _, p := range processes {
-			if err := destination.Add(p); err != nil {
-				return err
+			if err := destination.Add(p, p.Subsystem); err != nil {
+				if err == ErrProcessNotExist {
+					continue
+				}
+				if _, ok := failed[p.Pid]; !ok {
+					failed[p.Pid] = err
+				}
 			}
 		}
 	}
+	if len(failed) > 0 {
+		return &MoveError{Failed: failed}
+	}
 	return nil
 }
 
@@ -0,0 +1,147 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroup2
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// ControllerToggle enables or disables controllers
+type ControllerToggle int
+
+const (
+	Enable ControllerToggle = iota + 1
+	Disable
+)
+
+// Controllers returns the controllers available to the cgroup as listed in
+// its cgroup.controllers
+func (c *Manager) Controllers() ([]string, error) {
+	return readControllers(filepath.Join(c.path, "cgroup.controllers"))
+}
+
+// ToggleControllers makes the controllers available to the cgroup. When
+// enabling, they are added to the cgroup.subtree_control of every cgroup from
+// the mountpoint down to the parent of the cgroup. When disabling, they are
+// only removed from the parent so that siblings keep them.
+func (c *Manager) ToggleControllers(controllers []string, t ControllerToggle) error {
+	if len(controllers) == 0 {
+		return nil
+	}
+	var dirs []string
+	for dir := filepath.Dir(c.path); ; dir = filepath.Dir(dir) {
+		rel, err := filepath.Rel(c.unifiedMountpoint, dir)
+		if err != nil || strings.HasPrefix(rel, "..") || c.path == c.unifiedMountpoint {
+			break
+		}
+		dirs = append([]string{dir}, dirs...)
+		if rel == "." {
+			break
+		}
+	}
+	if t == Disable {
+		if len(dirs) == 0 {
+			return nil
+		}
+		dirs = dirs[len(dirs)-1:]
+	}
+	for _, dir := range dirs {
+		if err := toggleControllers(dir, controllers, t, dir == c.unifiedMountpoint); err != nil {
+			return &ControllerError{
+				Path:        dir,
+				Controllers: controllers,
+				Err:         err,
+			}
+		}
+	}
+	return nil
+}
+
+// toggleControllers writes the controllers that are not yet in the requested
+// state to the cgroup.subtree_control of the directory
+func toggleControllers(dir string, controllers []string, t ControllerToggle, root bool) error {
+	enabled, err := readControllers(filepath.Join(dir, "cgroup.subtree_control"))
+	if err != nil {
+		return err
+	}
+	var changes []string
+	for _, name := range controllers {
+		if contains(enabled, name) == (t == Enable) {
+			continue
+		}
+		if t == Disable {
+			changes = append(changes, "-"+name)
+			continue
+		}
+		available, err := readControllers(filepath.Join(dir, "cgroup.controllers"))
+		if err != nil {
+			return err
+		}
+		if !contains(available, name) {
+			return ErrControllerNotAvailable
+		}
+		changes = append(changes, "+"+name)
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	if err := ioutil.WriteFile(
+		filepath.Join(dir, "cgroup.subtree_control"),
+		[]byte(strings.Join(changes, " ")),
+		defaultFilePerm,
+	); err != nil {
+		// only the root cgroup may have processes and enable controllers
+		// for its children, the others have to move the processes to a leaf
+		if pathErr, ok := err.(*os.PathError); ok && pathErr.Err == syscall.EBUSY && !root {
+			if pids, perr := readPids(filepath.Join(dir, "cgroup.procs")); perr == nil && len(pids) > 0 {
+				return ErrInternalProcesses
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// readControllers returns the controllers listed in a cgroup.controllers or
+// cgroup.subtree_control file
+func readControllers(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var out []string
+	for _, name := range strings.Fields(string(data)) {
+		out = append(out, strings.TrimLeft(name, "+-"))
+	}
+	return out, nil
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
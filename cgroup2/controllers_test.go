@@ -0,0 +1,74 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroup2
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestToggleControllers(t *testing.T) {
+	root := newMountpoint(t)
+	defer os.RemoveAll(root)
+	parent, err := NewManager(root, "/parent", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, parent.Path(), map[string]string{
+		"cgroup.controllers": "cpu memory",
+	})
+	child, err := NewManager(root, "/parent/child", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := child.ToggleControllers([]string{"cpu", "memory"}, Enable); err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range []string{root, parent.Path()} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, "cgroup.subtree_control"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "+cpu +memory" {
+			t.Errorf("expected controllers to be enabled in %s but received %q", dir, data)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(child.Path(), "cgroup.subtree_control")); !os.IsNotExist(err) {
+		t.Errorf("expected the subtree_control of the child to be left untouched")
+	}
+	if err := child.ToggleControllers([]string{"memory"}, Disable); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(parent.Path(), "cgroup.subtree_control"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "-memory" {
+		t.Errorf("expected memory to be disabled in the parent but received %q", data)
+	}
+	err = child.ToggleControllers([]string{"pids"}, Enable)
+	if cerr, ok := err.(*ControllerError); !ok || cerr.Path != parent.Path() {
+		t.Fatalf("expected a controller error for %s but received %v", parent.Path(), err)
+	}
+	if errors.Cause(err) != ErrControllerNotAvailable {
+		t.Fatalf("expected error %q but received %v", ErrControllerNotAvailable, err)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroup2
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidPid       = errors.New("cgroups: pid must be greater than 0")
+	ErrInvalidGroupPath = errors.New("cgroups: invalid group path")
+	ErrInvalidFormat    = errors.New("cgroups: parsing file with invalid format failed")
+	ErrCgroupDeleted    = errors.New("cgroups: cgroup deleted")
+	ErrRootCgroup       = errors.New("cgroups: cannot create or delete the root cgroup")
+	ErrNotCgroup2       = errors.New("cgroups: mountpoint is not a cgroup2 filesystem")
+	ErrInvalidCPUWeight = errors.New("cgroups: cpu weight must be between 1 and 10000")
+	ErrInvalidCPUMax    = errors.New("cgroups: cpu max must be \"max\" or a quota of at least 1ms followed by a period between 1ms and 1s")
+	ErrInvalidIOWeight  = errors.New("cgroups: io weight must be between 1 and 10000")
+	ErrInvalidIOLimit   = errors.New("cgroups: invalid io limit")
+	ErrInvalidHugeTlb   = errors.New("cgroups: invalid hugetlb page size")
+	ErrInvalidRdma      = errors.New("cgroups: invalid rdma device")
+	// ErrControllerNotAvailable is returned when a controller is not listed
+	// in the cgroup.controllers of the cgroup that should enable it
+	ErrControllerNotAvailable = errors.New("cgroups: controller is not available")
+	// ErrInternalProcesses is returned when controllers cannot be enabled for
+	// the children of a cgroup because it has processes itself
+	ErrInternalProcesses = errors.New("cgroups: controllers cannot be enabled in a cgroup with processes")
+)
+
+// ControllerError is returned when controllers could not be toggled in the
+// cgroup.subtree_control of a cgroup
+type ControllerError struct {
+	// Path is the directory of the cgroup
+	Path string
+	// Controllers are the controllers that were toggled
+	Controllers []string
+	// Err is the reason the controllers could not be toggled
+	Err error
+}
+
+func (e *ControllerError) Error() string {
+	return fmt.Sprintf("cgroups: unable to toggle controllers %v in %s: %v", e.Controllers, e.Path, e.Err)
+}
+
+// Cause returns the reason the controllers could not be toggled
+func (e *ControllerError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the reason the controllers could not be toggled
+func (e *ControllerError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,333 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroup2
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/cgroups/cgroup2/stats"
+)
+
+// Manager manages a cgroup of the unified hierarchy
+type Manager struct {
+	unifiedMountpoint string
+	path              string
+}
+
+// NewManager creates the group below the mountpoint of the unified hierarchy,
+// such as /sys/fs/cgroup, and applies the resources. The controllers of the
+// resources are enabled along the path of the group. The root group cannot be
+// managed.
+func NewManager(mountpoint string, group string, resources *Resources) (*Manager, error) {
+	if err := VerifyGroupPath(group); err != nil {
+		return nil, err
+	}
+	if filepath.Clean(group) == "/" {
+		return nil, ErrRootCgroup
+	}
+	if err := verifyMountpoint(mountpoint); err != nil {
+		return nil, err
+	}
+	if resources != nil {
+		if err := resources.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	path := filepath.Join(mountpoint, group)
+	created, err := mkdirAll(path)
+	if err != nil {
+		return nil, err
+	}
+	// remove the directories created here so that a failed creation can be
+	// retried, a group that already existed is left in place
+	cleanup := func() {
+		for _, dir := range created {
+			os.Remove(dir)
+		}
+	}
+	m := &Manager{
+		unifiedMountpoint: mountpoint,
+		path:              path,
+	}
+	if resources != nil {
+		if err := m.ToggleControllers(resources.controllers(), Enable); err != nil {
+			cleanup()
+			return nil, err
+		}
+	}
+	if err := m.Update(resources); err != nil {
+		cleanup()
+		return nil, err
+	}
+	return m, nil
+}
+
+// LoadManager loads an existing group of the unified hierarchy
+func LoadManager(mountpoint string, group string) (*Manager, error) {
+	if err := VerifyGroupPath(group); err != nil {
+		return nil, err
+	}
+	if err := verifyMountpoint(mountpoint); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(mountpoint, group)
+	if _, err := os.Lstat(path); err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrCgroupDeleted
+		}
+		return nil, err
+	}
+	return &Manager{
+		unifiedMountpoint: mountpoint,
+		path:              path,
+	}, nil
+}
+
+// Path returns the directory of the cgroup
+func (c *Manager) Path() string {
+	return c.path
+}
+
+// Update validates and writes the resources to the interface files of the
+// cgroup
+func (c *Manager) Update(resources *Resources) error {
+	if resources == nil {
+		return nil
+	}
+	if err := resources.Validate(); err != nil {
+		return err
+	}
+	for _, v := range resources.Values() {
+		if err := ioutil.WriteFile(
+			filepath.Join(c.path, v.Filename),
+			[]byte(v.Value),
+			defaultFilePerm,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddProc moves the process and all its threads into the cgroup
+func (c *Manager) AddProc(pid int) error {
+	if pid <= 0 {
+		return ErrInvalidPid
+	}
+	return ioutil.WriteFile(
+		filepath.Join(c.path, "cgroup.procs"),
+		[]byte(strconv.Itoa(pid)),
+		defaultFilePerm,
+	)
+}
+
+// Procs returns the pids of the processes in the cgroup, including the ones
+// in its children when recursive is true
+func (c *Manager) Procs(recursive bool) ([]int, error) {
+	if !recursive {
+		return readPids(filepath.Join(c.path, "cgroup.procs"))
+	}
+	var pids []int
+	err := filepath.Walk(c.path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		out, err := readPids(filepath.Join(p, "cgroup.procs"))
+		if err != nil {
+			return err
+		}
+		pids = append(pids, out...)
+		return nil
+	})
+	return pids, err
+}
+
+// Stat returns the stats of the controllers enabled for the cgroup, the
+// stats of controllers that are not enabled are left empty
+func (c *Manager) Stat() (*stats.Metrics, error) {
+	var metrics stats.Metrics
+	for _, fn := range []func(*stats.Metrics) error{
+		c.pidsStat,
+		c.cpuStat,
+		c.memoryStat,
+		c.ioStat,
+	} {
+		if err := fn(&metrics); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return &metrics, nil
+}
+
+func (c *Manager) pidsStat(metrics *stats.Metrics) error {
+	current, err := readUint(filepath.Join(c.path, "pids.current"))
+	if err != nil {
+		return err
+	}
+	limit, err := readUint(filepath.Join(c.path, "pids.max"))
+	if err != nil {
+		return err
+	}
+	// an unlimited cgroup is reported with a limit of 0 like on v1
+	if limit == math.MaxUint64 {
+		limit = 0
+	}
+	metrics.Pids = &stats.PidsStat{
+		Current: current,
+		Limit:   limit,
+	}
+	return nil
+}
+
+func (c *Manager) cpuStat(metrics *stats.Metrics) error {
+	raw, err := readKVStatsFile(filepath.Join(c.path, "cpu.stat"))
+	if err != nil {
+		return err
+	}
+	metrics.CPU = &stats.CPUStat{
+		UsageUsec:     raw["usage_usec"],
+		UserUsec:      raw["user_usec"],
+		SystemUsec:    raw["system_usec"],
+		NrPeriods:     raw["nr_periods"],
+		NrThrottled:   raw["nr_throttled"],
+		ThrottledUsec: raw["throttled_usec"],
+	}
+	return nil
+}
+
+func (c *Manager) memoryStat(metrics *stats.Metrics) error {
+	raw, err := readKVStatsFile(filepath.Join(c.path, "memory.stat"))
+	if err != nil {
+		return err
+	}
+	m := &stats.MemoryStat{
+		Anon:          raw["anon"],
+		File:          raw["file"],
+		KernelStack:   raw["kernel_stack"],
+		Slab:          raw["slab"],
+		Sock:          raw["sock"],
+		Shmem:         raw["shmem"],
+		FileMapped:    raw["file_mapped"],
+		FileDirty:     raw["file_dirty"],
+		FileWriteback: raw["file_writeback"],
+		AnonThp:       raw["anon_thp"],
+		InactiveAnon:  raw["inactive_anon"],
+		ActiveAnon:    raw["active_anon"],
+		InactiveFile:  raw["inactive_file"],
+		ActiveFile:    raw["active_file"],
+		Unevictable:   raw["unevictable"],
+		Pgfault:       raw["pgfault"],
+		Pgmajfault:    raw["pgmajfault"],
+	}
+	for _, t := range []struct {
+		name     string
+		value    *uint64
+		optional bool
+	}{
+		{
+			name:  "memory.current",
+			value: &m.Usage,
+		},
+		{
+			name:  "memory.max",
+			value: &m.UsageLimit,
+		},
+		{
+			// the swap files are missing on kernels booted with swapaccount=0
+			name:     "memory.swap.current",
+			value:    &m.SwapUsage,
+			optional: true,
+		},
+		{
+			name:     "memory.swap.max",
+			value:    &m.SwapLimit,
+			optional: true,
+		},
+	} {
+		v, err := readUint(filepath.Join(c.path, t.name))
+		if err != nil {
+			if t.optional && os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		*t.value = v
+	}
+	metrics.Memory = m
+	return nil
+}
+
+func (c *Manager) ioStat(metrics *stats.Metrics) error {
+	f, err := os.Open(filepath.Join(c.path, "io.stat"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		// format: 8:0 rbytes=4096 wbytes=0 rios=1 wios=0 dbytes=0 dios=0
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		var entry stats.IOEntry
+		if _, err := fmt.Sscanf(fields[0], "%d:%d", &entry.Major, &entry.Minor); err != nil {
+			return ErrInvalidFormat
+		}
+		for _, field := range fields[1:] {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 {
+				return ErrInvalidFormat
+			}
+			v, err := strconv.ParseUint(parts[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			switch parts[0] {
+			case "rbytes":
+				entry.Rbytes = v
+			case "wbytes":
+				entry.Wbytes = v
+			case "rios":
+				entry.Rios = v
+			case "wios":
+				entry.Wios = v
+			}
+		}
+		metrics.IO = append(metrics.IO, &entry)
+	}
+	return sc.Err()
+}
+
+// Delete removes the cgroup and its children, they must not have any
+// processes left. The root cgroup cannot be deleted.
+func (c *Manager) Delete() error {
+	if filepath.Clean(c.path) == filepath.Clean(c.unifiedMountpoint) {
+		return ErrRootCgroup
+	}
+	return remove(c.path)
+}
@@ -0,0 +1,216 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroup2
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func init() {
+	defaultFilePerm = 0666
+	verifyMountpoint = func(string) error {
+		return nil
+	}
+}
+
+func newMountpoint(t *testing.T) string {
+	root, err := ioutil.TempDir("", "cgroup2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, root, map[string]string{
+		"cgroup.controllers": "cpuset cpu io memory hugetlb pids rdma",
+	})
+	return root
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, value := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(value), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestNewManager(t *testing.T) {
+	root := newMountpoint(t)
+	defer os.RemoveAll(root)
+	var (
+		weight = uint64(100)
+		max    = int64(-1)
+		pids   = int64(10)
+	)
+	m, err := NewManager(root, "/test", &Resources{
+		CPU: &CPU{
+			Weight: &weight,
+		},
+		Memory: &Memory{
+			Max: &max,
+		},
+		Pids: &Pids{
+			Max: &pids,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, expected := range map[string]string{
+		"cpu.weight": "100",
+		"memory.max": "max",
+		"pids.max":   "10",
+	} {
+		data, err := ioutil.ReadFile(filepath.Join(m.Path(), name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != expected {
+			t.Errorf("expected %s to be %q but received %q", name, expected, data)
+		}
+	}
+	if _, err := LoadManager(root, "/test"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Delete(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadManager(root, "/test"); err != ErrCgroupDeleted {
+		t.Fatalf("expected error %q but received %v", ErrCgroupDeleted, err)
+	}
+	if _, err := NewManager(root, "test", nil); err != ErrInvalidGroupPath {
+		t.Fatalf("expected error %q but received %v", ErrInvalidGroupPath, err)
+	}
+	if _, err := NewManager(root, "/", nil); err != ErrRootCgroup {
+		t.Fatalf("expected error %q but received %v", ErrRootCgroup, err)
+	}
+	rm, err := LoadManager(root, "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rm.Delete(); err != ErrRootCgroup {
+		t.Fatalf("expected error %q but received %v", ErrRootCgroup, err)
+	}
+}
+
+func TestCgroup2Mountpoint(t *testing.T) {
+	root := newMountpoint(t)
+	defer os.RemoveAll(root)
+	if err := cgroup2Mountpoint(root); err != ErrNotCgroup2 {
+		t.Fatalf("expected error %q but received %v", ErrNotCgroup2, err)
+	}
+}
+
+func TestNewManagerCleanup(t *testing.T) {
+	root := newMountpoint(t)
+	defer os.RemoveAll(root)
+	if err := os.Mkdir(filepath.Join(root, "existing"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, root, map[string]string{
+		"cgroup.controllers": "cpu",
+	})
+	pids := int64(10)
+	resources := &Resources{
+		Pids: &Pids{
+			Max: &pids,
+		},
+	}
+	for _, group := range []string{"/existing", "/parent/child"} {
+		if _, err := NewManager(root, group, resources); !errors.Is(err, ErrControllerNotAvailable) {
+			t.Fatalf("expected error %q but received %v", ErrControllerNotAvailable, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "existing")); err != nil {
+		t.Fatalf("expected the existing group to be kept but received %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "parent")); !os.IsNotExist(err) {
+		t.Fatalf("expected the created parent to be removed but received %v", err)
+	}
+}
+
+func TestManagerProcs(t *testing.T) {
+	root := newMountpoint(t)
+	defer os.RemoveAll(root)
+	m, err := NewManager(root, "/test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AddProc(0); err != ErrInvalidPid {
+		t.Fatalf("expected error %q but received %v", ErrInvalidPid, err)
+	}
+	if err := m.AddProc(1234); err != nil {
+		t.Fatal(err)
+	}
+	child, err := NewManager(root, "/test/child", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := child.AddProc(5678); err != nil {
+		t.Fatal(err)
+	}
+	pids, err := m.Procs(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pids) != 1 || pids[0] != 1234 {
+		t.Fatalf("expected pid 1234 but received %v", pids)
+	}
+	pids, err = m.Procs(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pids) != 2 || pids[1] != 5678 {
+		t.Fatalf("expected pids 1234 and 5678 but received %v", pids)
+	}
+}
+
+func TestManagerStat(t *testing.T) {
+	root := newMountpoint(t)
+	defer os.RemoveAll(root)
+	m, err := NewManager(root, "/test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, m.Path(), map[string]string{
+		"pids.current":   "3",
+		"pids.max":       "max",
+		"cpu.stat":       "usage_usec 100\nuser_usec 60\nsystem_usec 40\nnr_periods 5\nnr_throttled 2\nthrottled_usec 30\n",
+		"memory.stat":    "anon 4096\nfile 8192\npgfault 7\n",
+		"memory.current": "12288",
+		"memory.max":     "max",
+		"io.stat":        "8:0 rbytes=4096 wbytes=8192 rios=1 wios=2 dbytes=0 dios=0\n",
+	})
+	metrics, err := m.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metrics.Pids.Current != 3 || metrics.Pids.Limit != 0 {
+		t.Errorf("unexpected pids stats %+v", metrics.Pids)
+	}
+	if metrics.CPU.UsageUsec != 100 || metrics.CPU.NrThrottled != 2 || metrics.CPU.ThrottledUsec != 30 {
+		t.Errorf("unexpected cpu stats %+v", metrics.CPU)
+	}
+	if metrics.Memory.Anon != 4096 || metrics.Memory.Usage != 12288 || metrics.Memory.SwapUsage != 0 {
+		t.Errorf("unexpected memory stats %+v", metrics.Memory)
+	}
+	if len(metrics.IO) != 1 || metrics.IO[0].Major != 8 || metrics.IO[0].Wbytes != 8192 || metrics.IO[0].Wios != 2 {
+		t.Errorf("unexpected io stats %+v", metrics.IO)
+	}
+}
@@ -0,0 +1,332 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroup2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Resources are the limits applied to a cgroup of the unified hierarchy,
+// unset fields leave the current values of the cgroup untouched
+type Resources struct {
+	CPU     *CPU
+	Memory  *Memory
+	Pids    *Pids
+	IO      *IO
+	HugeTlb []HugeTlb
+	Rdma    []Rdma
+}
+
+// CPU configures the cpu and cpuset controllers
+type CPU struct {
+	// Weight is the relative share of cpu time, from 1 to 10000
+	Weight *uint64
+	// Max is the bandwidth limit written to cpu.max, see NewCPUMax
+	Max CPUMax
+	// Cpus and Mems are the cpus and memory nodes the tasks may use
+	Cpus string
+	Mems string
+}
+
+// CPUMax is the content of cpu.max, the quota and the period in
+// microseconds such as "50000 100000", a quota of "max" removes the limit
+type CPUMax string
+
+// NewCPUMax returns the cpu.max content for the quota and period of the
+// runtime spec, a nil, 0 or -1 quota removes the limit as in the cgroup v1
+// cpu.cfs_quota_us where 0 is the unset value
+func NewCPUMax(quota *int64, period *uint64) CPUMax {
+	max := "max"
+	if quota != nil && *quota != -1 && *quota != 0 {
+		max = strconv.FormatInt(*quota, 10)
+	}
+	if period != nil {
+		max += " " + strconv.FormatUint(*period, 10)
+	}
+	return CPUMax(max)
+}
+
+const (
+	minCFSQuota  = 1000
+	minCFSPeriod = 1000
+	maxCFSPeriod = 1000000
+)
+
+func (m CPUMax) validate() error {
+	fields := strings.Fields(string(m))
+	if len(fields) == 0 || len(fields) > 2 {
+		return ErrInvalidCPUMax
+	}
+	if fields[0] != "max" {
+		quota, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil || quota < minCFSQuota {
+			return ErrInvalidCPUMax
+		}
+	}
+	if len(fields) == 2 {
+		period, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil || period < minCFSPeriod || period > maxCFSPeriod {
+			return ErrInvalidCPUMax
+		}
+	}
+	return nil
+}
+
+// Memory configures the memory controller, the limits are in bytes and -1
+// removes a limit
+type Memory struct {
+	Max *int64
+	// Swap limits the swap usage alone, unlike the combined memory and swap
+	// limit of v1
+	Swap *int64
+	High *int64
+	Low  *int64
+}
+
+// Pids configures the pids controller
+type Pids struct {
+	// Max is the maximum number of tasks, -1 removes the limit
+	Max *int64
+}
+
+// IO configures the io controller
+type IO struct {
+	// Weight is the default weight of the cgroup, from 1 to 10000
+	Weight *uint64
+	// Max are the bandwidth and iops limits of the devices
+	Max []IOLimit
+}
+
+// IOType is the kind of io.max limit
+type IOType string
+
+const (
+	ReadBPS   IOType = "rbps"
+	WriteBPS  IOType = "wbps"
+	ReadIOPS  IOType = "riops"
+	WriteIOPS IOType = "wiops"
+)
+
+// IOLimit limits the io of a device, written as "8:0 rbps=1048576"
+type IOLimit struct {
+	Major int64
+	Minor int64
+	Type  IOType
+	Rate  uint64
+}
+
+// HugeTlb limits the usage of huge pages of a size, such as "2MB"
+type HugeTlb struct {
+	HugePageSize string
+	// Limit is in bytes and -1 removes the limit
+	Limit *int64
+}
+
+// Rdma limits the rdma resources of a device, such as "mlx4_0"
+type Rdma struct {
+	Device     string
+	HcaHandles *uint32
+	HcaObjects *uint32
+}
+
+// Value is the content to write to an interface file of a cgroup, files such
+// as io.max take one Value per device
+type Value struct {
+	Filename string
+	Value    string
+}
+
+// Validate checks the resources before they are written so that callers get
+// a meaningful error instead of EINVAL
+func (r *Resources) Validate() error {
+	if r.CPU != nil {
+		if w := r.CPU.Weight; w != nil && (*w < 1 || *w > 10000) {
+			return ErrInvalidCPUWeight
+		}
+		if r.CPU.Max != "" {
+			if err := r.CPU.Max.validate(); err != nil {
+				return err
+			}
+		}
+	}
+	if r.IO != nil {
+		if w := r.IO.Weight; w != nil && (*w < 1 || *w > 10000) {
+			return ErrInvalidIOWeight
+		}
+		for _, l := range r.IO.Max {
+			switch l.Type {
+			case ReadBPS, WriteBPS, ReadIOPS, WriteIOPS:
+			default:
+				return ErrInvalidIOLimit
+			}
+			if l.Major < 0 || l.Minor < 0 {
+				return ErrInvalidIOLimit
+			}
+		}
+	}
+	for _, h := range r.HugeTlb {
+		if h.HugePageSize == "" || strings.ContainsAny(h.HugePageSize, "./") {
+			return ErrInvalidHugeTlb
+		}
+	}
+	for _, l := range r.Rdma {
+		if l.Device == "" || strings.ContainsAny(l.Device, " =") {
+			return ErrInvalidRdma
+		}
+		if l.HcaHandles == nil && l.HcaObjects == nil {
+			return ErrInvalidRdma
+		}
+	}
+	return nil
+}
+
+// Values returns the interface files and their content for the resources,
+// the cpuset placement is returned first
+func (r *Resources) Values() []Value {
+	var values []Value
+	if r.CPU != nil {
+		for _, t := range []struct {
+			name  string
+			value string
+		}{
+			{
+				name:  "cpuset.cpus",
+				value: r.CPU.Cpus,
+			},
+			{
+				name:  "cpuset.mems",
+				value: r.CPU.Mems,
+			},
+			{
+				name:  "cpu.max",
+				value: string(r.CPU.Max),
+			},
+		} {
+			if t.value != "" {
+				values = append(values, Value{
+					Filename: t.name,
+					Value:    t.value,
+				})
+			}
+		}
+		if r.CPU.Weight != nil {
+			values = append(values, Value{
+				Filename: "cpu.weight",
+				Value:    strconv.FormatUint(*r.CPU.Weight, 10),
+			})
+		}
+	}
+	if r.Memory != nil {
+		for _, t := range []struct {
+			name  string
+			value *int64
+		}{
+			{
+				name:  "memory.max",
+				value: r.Memory.Max,
+			},
+			{
+				name:  "memory.swap.max",
+				value: r.Memory.Swap,
+			},
+			{
+				name:  "memory.high",
+				value: r.Memory.High,
+			},
+			{
+				name:  "memory.low",
+				value: r.Memory.Low,
+			},
+		} {
+			if t.value != nil {
+				values = append(values, Value{
+					Filename: t.name,
+					Value:    formatLimit(*t.value),
+				})
+			}
+		}
+	}
+	if r.Pids != nil && r.Pids.Max != nil {
+		values = append(values, Value{
+			Filename: "pids.max",
+			Value:    formatLimit(*r.Pids.Max),
+		})
+	}
+	if r.IO != nil {
+		if r.IO.Weight != nil {
+			values = append(values, Value{
+				Filename: "io.weight",
+				Value:    fmt.Sprintf("default %d", *r.IO.Weight),
+			})
+		}
+		for _, l := range r.IO.Max {
+			values = append(values, Value{
+				Filename: "io.max",
+				Value:    fmt.Sprintf("%d:%d %s=%d", l.Major, l.Minor, l.Type, l.Rate),
+			})
+		}
+	}
+	for _, h := range r.HugeTlb {
+		if h.Limit == nil {
+			continue
+		}
+		values = append(values, Value{
+			Filename: fmt.Sprintf("hugetlb.%s.max", h.HugePageSize),
+			Value:    formatLimit(*h.Limit),
+		})
+	}
+	for _, l := range r.Rdma {
+		var limits []string
+		if l.HcaHandles != nil {
+			limits = append(limits, fmt.Sprintf("hca_handle=%d", *l.HcaHandles))
+		}
+		if l.HcaObjects != nil {
+			limits = append(limits, fmt.Sprintf("hca_object=%d", *l.HcaObjects))
+		}
+		if len(limits) == 0 {
+			continue
+		}
+		values = append(values, Value{
+			Filename: "rdma.max",
+			Value:    l.Device + " " + strings.Join(limits, " "),
+		})
+	}
+	return values
+}
+
+// controllers returns the controllers of the interface files written for the
+// resources
+func (r *Resources) controllers() []string {
+	var out []string
+	for _, v := range r.Values() {
+		name := strings.SplitN(v.Filename, ".", 2)[0]
+		if !contains(out, name) {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// formatLimit returns "max" for negative limits which removes the limit
+func formatLimit(v int64) string {
+	if v < 0 {
+		return "max"
+	}
+	return strconv.FormatInt(v, 10)
+}
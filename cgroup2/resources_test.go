@@ -0,0 +1,157 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroup2
+
+import "testing"
+
+func TestNewCPUMax(t *testing.T) {
+	var (
+		quota     = int64(50000)
+		unlimited = int64(-1)
+		unset     = int64(0)
+		period    = uint64(100000)
+	)
+	for _, tt := range []struct {
+		quota    *int64
+		period   *uint64
+		expected CPUMax
+	}{
+		{quota: &quota, period: &period, expected: "50000 100000"},
+		{quota: &unlimited, period: &period, expected: "max 100000"},
+		{quota: &unset, period: &period, expected: "max 100000"},
+		{quota: &quota, expected: "50000"},
+		{expected: "max"},
+	} {
+		if max := NewCPUMax(tt.quota, tt.period); max != tt.expected {
+			t.Errorf("expected cpu max %q but received %q", tt.expected, max)
+		}
+	}
+}
+
+func TestResourcesValues(t *testing.T) {
+	var (
+		weight  = uint64(100)
+		memory  = int64(1024)
+		pids    = int64(0)
+		huge    = int64(-1)
+		handles = uint32(2)
+	)
+	r := &Resources{
+		CPU: &CPU{
+			Weight: &weight,
+			Max:    "max 100000",
+			Cpus:   "0-1",
+		},
+		Memory: &Memory{
+			Max:  &memory,
+			Swap: &memory,
+		},
+		IO: &IO{
+			Weight: &weight,
+			Max: []IOLimit{
+				{Major: 8, Minor: 0, Type: ReadBPS, Rate: 1048576},
+			},
+		},
+		Pids: &Pids{
+			Max: &pids,
+		},
+		HugeTlb: []HugeTlb{
+			{HugePageSize: "2MB", Limit: &memory},
+			{HugePageSize: "1GB", Limit: &huge},
+		},
+		Rdma: []Rdma{
+			{Device: "mlx4_0", HcaHandles: &handles},
+		},
+	}
+	if err := r.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []Value{
+		{Filename: "cpuset.cpus", Value: "0-1"},
+		{Filename: "cpu.max", Value: "max 100000"},
+		{Filename: "cpu.weight", Value: "100"},
+		{Filename: "memory.max", Value: "1024"},
+		{Filename: "memory.swap.max", Value: "1024"},
+		{Filename: "pids.max", Value: "0"},
+		{Filename: "io.weight", Value: "default 100"},
+		{Filename: "io.max", Value: "8:0 rbps=1048576"},
+		{Filename: "hugetlb.2MB.max", Value: "1024"},
+		{Filename: "hugetlb.1GB.max", Value: "max"},
+		{Filename: "rdma.max", Value: "mlx4_0 hca_handle=2"},
+	}
+	values := r.Values()
+	if len(values) != len(expected) {
+		t.Fatalf("expected %d values but received %+v", len(expected), values)
+	}
+	for i, v := range values {
+		if v != expected[i] {
+			t.Errorf("expected value %+v but received %+v", expected[i], v)
+		}
+	}
+}
+
+func TestResourcesValidate(t *testing.T) {
+	var (
+		zero = uint64(0)
+		huge = uint64(10001)
+	)
+	for _, tt := range []struct {
+		resources Resources
+		err       error
+	}{
+		{
+			resources: Resources{CPU: &CPU{Weight: &zero}},
+			err:       ErrInvalidCPUWeight,
+		},
+		{
+			resources: Resources{CPU: &CPU{Max: "100 100000"}},
+			err:       ErrInvalidCPUMax,
+		},
+		{
+			resources: Resources{CPU: &CPU{Max: "max 2000000"}},
+			err:       ErrInvalidCPUMax,
+		},
+		{
+			resources: Resources{CPU: &CPU{Max: "max max"}},
+			err:       ErrInvalidCPUMax,
+		},
+		{
+			resources: Resources{IO: &IO{Weight: &huge}},
+			err:       ErrInvalidIOWeight,
+		},
+		{
+			resources: Resources{IO: &IO{Max: []IOLimit{{Major: 8, Type: "bps"}}}},
+			err:       ErrInvalidIOLimit,
+		},
+		{
+			resources: Resources{HugeTlb: []HugeTlb{{HugePageSize: "../2MB"}}},
+			err:       ErrInvalidHugeTlb,
+		},
+		{
+			resources: Resources{Rdma: []Rdma{{Device: ""}}},
+			err:       ErrInvalidRdma,
+		},
+		{
+			resources: Resources{Rdma: []Rdma{{Device: "mlx4_0"}}},
+			err:       ErrInvalidRdma,
+		},
+	} {
+		if err := tt.resources.Validate(); err != tt.err {
+			t.Errorf("expected error %v but received %v", tt.err, err)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroup2
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const defaultDirPerm = 0755
+
+// defaultFilePerm is a var so that the test framework can change the filemode
+// of all files created when the tests are running, the interface files
+// already exist on a real cgroup filesystem
+var defaultFilePerm = os.FileMode(0)
+
+// verifyMountpoint is a var so that the test framework can use a temporary
+// directory as the mountpoint of the unified hierarchy
+var verifyMountpoint = cgroup2Mountpoint
+
+// cgroup2Mountpoint returns ErrNotCgroup2 if the mountpoint is not the root of
+// a cgroup2 filesystem
+func cgroup2Mountpoint(mountpoint string) error {
+	var st unix.Statfs_t
+	if err := unix.Statfs(mountpoint, &st); err != nil {
+		return err
+	}
+	if st.Type != unix.CGROUP2_SUPER_MAGIC {
+		return ErrNotCgroup2
+	}
+	return nil
+}
+
+// VerifyGroupPath returns ErrInvalidGroupPath if the group is not an absolute
+// path without parent references, such as "/system.slice/foo.scope"
+func VerifyGroupPath(group string) error {
+	if !strings.HasPrefix(group, "/") || strings.ContainsRune(group, 0) {
+		return ErrInvalidGroupPath
+	}
+	for _, e := range strings.Split(group, "/") {
+		if e == ".." {
+			return ErrInvalidGroupPath
+		}
+	}
+	return nil
+}
+
+// mkdirAll creates the cgroup and its missing parents, it returns the
+// directories that were created with the deepest first
+func mkdirAll(path string) ([]string, error) {
+	var created []string
+	for p := path; ; p = filepath.Dir(p) {
+		if _, err := os.Lstat(p); err == nil {
+			break
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+		created = append(created, p)
+		if filepath.Dir(p) == p {
+			break
+		}
+	}
+	if err := os.MkdirAll(path, defaultDirPerm); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// readUint reads an interface file holding a single value, "max" is read as
+// math.MaxUint64
+func readUint(path string) (uint64, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return parseUint(strings.TrimSpace(string(data)))
+}
+
+func parseUint(s string) (uint64, error) {
+	if s == "max" {
+		return math.MaxUint64, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// readKVStatsFile parses files such as cpu.stat and memory.stat with a key
+// and a value on each line
+func readKVStatsFile(path string) (map[string]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var (
+		out = make(map[string]uint64)
+		sc  = bufio.NewScanner(f)
+	)
+	for sc.Scan() {
+		parts := strings.Fields(sc.Text())
+		if len(parts) != 2 {
+			return nil, ErrInvalidFormat
+		}
+		v, err := parseUint(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		out[parts[0]] = v
+	}
+	return out, sc.Err()
+}
+
+// readPids returns the pids listed in a cgroup.procs file
+func readPids(path string) ([]int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parsePids(f)
+}
+
+func parsePids(r io.Reader) ([]int, error) {
+	var (
+		out []int
+		sc  = bufio.NewScanner(r)
+	)
+	for sc.Scan() {
+		if t := sc.Text(); t != "" {
+			pid, err := strconv.Atoi(t)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, pid)
+		}
+	}
+	return out, sc.Err()
+}
+
+// remove removes the cgroup and its children bottom up, the kernel only
+// allows empty cgroups without children to be removed
+func remove(path string) error {
+	var dirs []string
+	if err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			dirs = append(dirs, p)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := removeDir(dirs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func removeDir(path string) error {
+	delay := 10 * time.Millisecond
+	for i := 0; i < 5; i++ {
+		if i != 0 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+		if err := os.RemoveAll(path); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("cgroups: unable to remove path %q", path)
+}
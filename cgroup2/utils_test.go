@@ -0,0 +1,36 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroup2
+
+import "testing"
+
+func TestVerifyGroupPath(t *testing.T) {
+	for _, tt := range []struct {
+		group string
+		err   error
+	}{
+		{group: "/"},
+		{group: "/system.slice/foo.scope"},
+		{group: "foo", err: ErrInvalidGroupPath},
+		{group: "/foo/../../bar", err: ErrInvalidGroupPath},
+		{group: "/foo\x00", err: ErrInvalidGroupPath},
+	} {
+		if err := VerifyGroupPath(tt.group); err != tt.err {
+			t.Errorf("expected error %v for %q but received %v", tt.err, tt.group, err)
+		}
+	}
+}
@@ -17,14 +17,18 @@
 package cgroups
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"syscall"
 	"testing"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 )
 
 // using t.Error in test were defers do cleanup on the filesystem
@@ -78,6 +82,41 @@ func TestStat(t *testing.T) {
 	}
 }
 
+func TestStatPartiallyDeleted(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for _, f := range []string{"pids.current", "pids.max"} {
+		if err := ioutil.WriteFile(filepath.Join(mock.root, string(Pids), "test", f), []byte("3"), defaultFilePerm); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if err := os.RemoveAll(filepath.Join(mock.root, string(Memory), "test")); err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := control.Stat(); err == nil {
+		t.Error("expected error for the removed memory cgroup")
+		return
+	}
+	s, err := control.Stat(IgnoreNotExist)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if s.Pids == nil || s.Pids.Current != 3 {
+		t.Errorf("expected pids stats to be assembled but received %v", s.Pids)
+	}
+}
+
 func TestAdd(t *testing.T) {
 	mock, err := newMock()
 	if err != nil {
@@ -124,6 +163,33 @@ func TestAddTask(t *testing.T) {
 	}
 }
 
+func TestAddFilteredSubsystems(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	filter := []Name{Memory, Cpu}
+	if err := control.Add(Process{Pid: 1234}, filter...); err != nil {
+		t.Error(err)
+		return
+	}
+	for _, s := range filter {
+		if err := checkPid(mock, filepath.Join(string(s), "test"), 1234); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if _, err := os.Stat(filepath.Join(mock.root, string(Freezer), "test", cgroupProcs)); !os.IsNotExist(err) {
+		t.Errorf("expected process not to be added to the freezer cgroup but received %v", err)
+	}
+}
+
 func TestListPids(t *testing.T) {
 	mock, err := newMock()
 	if err != nil {
@@ -317,6 +383,42 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestDeleteMoveToParent(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	child, err := control.New("child", &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := child.Add(Process{Pid: 1234}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Delete(MoveProcessesToParent); err != nil {
+		t.Error(err)
+		return
+	}
+	for _, s := range Subsystems() {
+		if err := checkPid(mock, string(s), 1234); err != nil {
+			t.Error(err)
+			return
+		}
+		if _, err := os.Stat(filepath.Join(mock.root, string(s), "test")); !os.IsNotExist(err) {
+			t.Errorf("expected group %s to be removed but received %v", s, err)
+			return
+		}
+	}
+}
+
 func TestCreateSubCgroup(t *testing.T) {
 	mock, err := newMock()
 	if err != nil {
@@ -435,3 +537,1103 @@ func TestCpusetParent(t *testing.T) {
 		}
 	}
 }
+
+func TestCpusetCloneChildren(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	if _, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{}, WithCpusetCloneChildren()); err != nil {
+		t.Error(err)
+		return
+	}
+	v, err := readValue(mock, filepath.Join(string(Cpuset), "test", "cgroup.clone_children"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if v != "1" {
+		t.Errorf("expected cgroup.clone_children to be 1 but received %q", v)
+	}
+}
+
+func TestCpusetExclusive(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.SetCpusetExclusive(true, false); err != nil {
+		t.Error(err)
+		return
+	}
+	for _, v := range []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "cpuset.cpu_exclusive",
+			expected: "1",
+		},
+		{
+			name:     "cpuset.mem_exclusive",
+			expected: "0",
+		},
+	} {
+		value, err := readValue(mock, filepath.Join("cpuset", "test", v.name))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if value != v.expected {
+			t.Errorf("expected %s to be %q but received %q", v.name, v.expected, value)
+		}
+	}
+}
+
+func TestCpusetPlacement(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for _, v := range []struct {
+		name  string
+		value string
+	}{
+		{
+			name:  "cpuset.effective_cpus",
+			value: "0-1\n",
+		},
+		{
+			name:  "cpuset.effective_mems",
+			value: "0\n",
+		},
+		{
+			name:  "cpuset.memory_migrate",
+			value: "1\n",
+		},
+		{
+			name:  "cpuset.memory_spread_page",
+			value: "0\n",
+		},
+		{
+			name:  "cpuset.memory_spread_slab",
+			value: "0\n",
+		},
+	} {
+		if err := ioutil.WriteFile(filepath.Join(mock.root, "cpuset", "test", v.name), []byte(v.value), defaultFilePerm); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	p, err := control.CpusetPlacement()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if p.EffectiveCpus != "0-1" || p.EffectiveMems != "0" {
+		t.Errorf("unexpected effective cpus %q and mems %q", p.EffectiveCpus, p.EffectiveMems)
+	}
+	if !p.MemoryMigrate || p.MemorySpreadPage || p.MemorySpreadSlab {
+		t.Errorf("unexpected memory flags %+v", p)
+		return
+	}
+	// older kernels only provide the configured cpus and mems
+	for _, name := range []string{"cpuset.effective_cpus", "cpuset.effective_mems"} {
+		if err := os.Remove(filepath.Join(mock.root, "cpuset", "test", name)); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	p, err = control.CpusetPlacement()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if p.EffectiveCpus != "0-3" || p.EffectiveMems != "0-3" {
+		t.Errorf("expected the configured cpus and mems but received %q and %q", p.EffectiveCpus, p.EffectiveMems)
+	}
+}
+
+func TestPerfEventOnly(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	perf := func() ([]Subsystem, error) {
+		return []Subsystem{NewPerfEvent(mock.root)}, nil
+	}
+	control, err := New(perf, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Add(Process{Pid: 1234}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := checkPid(mock, filepath.Join(string(PerfEvent), "test"), 1234); err != nil {
+		t.Error(err)
+		return
+	}
+	procs, err := control.Processes(PerfEvent, false)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(procs) != 1 || procs[0].Pid != 1234 {
+		t.Errorf("expected pid 1234 in perf_event cgroup but received %v", procs)
+	}
+}
+
+func TestListPidsNotActive(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(SingleSubsystem(mock.hierarchy, Freezer), StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := control.Processes(Memory, true); err != ErrControllerNotActive {
+		t.Errorf("expected ErrControllerNotActive listing processes but received %v", err)
+	}
+	if _, err := control.Tasks(Memory, true); err != ErrControllerNotActive {
+		t.Errorf("expected ErrControllerNotActive listing tasks but received %v", err)
+	}
+}
+
+func TestMoveTo(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	source, err := New(mock.hierarchy, StaticPath("source"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := source.Add(Process{Pid: 1234}); err != nil {
+		t.Error(err)
+		return
+	}
+	destination, err := New(mock.hierarchy, StaticPath("destination"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	// make the write of the memory cgroup fail
+	if err := os.Mkdir(filepath.Join(mock.root, string(Memory), "destination", cgroupProcs), defaultDirPerm); err != nil {
+		t.Error(err)
+		return
+	}
+	err = source.MoveTo(destination)
+	merr, ok := err.(*MoveError)
+	if !ok {
+		t.Errorf("expected *MoveError but received %v", err)
+		return
+	}
+	if _, ok := merr.Failed[1234]; !ok || len(merr.Failed) != 1 {
+		t.Errorf("expected pid 1234 to fail but received %v", merr.Failed)
+		return
+	}
+	if errs := merr.Unwrap(); len(errs) != 1 || errs[0] != merr.Failed[1234] {
+		t.Errorf("expected the error of pid 1234 to be unwrapped but received %v", errs)
+		return
+	}
+	if err := checkPid(mock, filepath.Join(string(Freezer), "destination"), 1234); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for _, name := range []string{"a", "b"} {
+		child, err := control.New(name, &specs.LinuxResources{})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if _, err := child.New("nested", &specs.LinuxResources{}); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	var paths []string
+	if err := control.Walk(func(path string, cg Cgroup) error {
+		paths = append(paths, path)
+		if path == "b" {
+			return filepath.SkipDir
+		}
+		return cg.Add(Process{Pid: 1234})
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	expected := []string{"a", filepath.Join("a", "nested"), "b"}
+	if len(paths) != len(expected) {
+		t.Errorf("expected paths %v but received %v", expected, paths)
+		return
+	}
+	for i := range expected {
+		if paths[i] != expected[i] {
+			t.Errorf("expected paths %v but received %v", expected, paths)
+			return
+		}
+	}
+	if err := checkPid(mock, filepath.Join(string(Memory), "test", "a", "nested"), 1234); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateUnchanged(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	handles := uint32(10)
+	resources := &specs.LinuxResources{
+		Pids: &specs.LinuxPids{Limit: 10},
+		Rdma: map[string]specs.LinuxRdma{
+			"mlx4_0": {HcaHandles: &handles},
+		},
+	}
+	control, err := New(mock.hierarchy, StaticPath("test"), resources)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var (
+		max  = filepath.Join(string(Pids), "test", "pids.max")
+		rdma = filepath.Join(string(Rdma), "test", "rdma.max")
+	)
+	// pids is compared with its files, rdma cannot be compared and is
+	// always rewritten
+	for path, value := range map[string]string{
+		max:  "99",
+		rdma: "mlx4_0 hca_handle=99",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(mock.root, path), []byte(value), defaultFilePerm); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	shares := uint64(512)
+	resources.CPU = &specs.LinuxCPU{Shares: &shares}
+	if err := control.Update(resources); err != nil {
+		t.Error(err)
+		return
+	}
+	for path, expected := range map[string]string{
+		max:  "10",
+		rdma: "mlx4_0 hca_handle=10",
+		filepath.Join(string(Cpu), "test", "cpu.shares"): "512",
+	} {
+		v, err := readValue(mock, path)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if v != expected {
+			t.Errorf("expected %q in %s but received %q", expected, path, v)
+			return
+		}
+	}
+	if err := control.WriteFile(Rdma, "rdma.max", []byte("mlx4_0 hca_handle=1")); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Update(resources); err != nil {
+		t.Error(err)
+		return
+	}
+	v, err := readValue(mock, rdma)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if v != "mlx4_0 hca_handle=10" {
+		t.Errorf("expected rdma limit %q but received %q", "mlx4_0 hca_handle=10", v)
+		return
+	}
+	resources.Pids.Limit = 20
+	if err := control.Update(resources); err != nil {
+		t.Error(err)
+		return
+	}
+	v, err = readValue(mock, max)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if v != "20" {
+		t.Errorf("expected pids limit %q but received %q", "20", v)
+	}
+}
+
+func TestNotifyOnRelease(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.SetNotifyOnRelease(true); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := SetReleaseAgent(SingleSubsystem(mock.hierarchy, Memory), "/usr/bin/cleanup"); err != nil {
+		t.Error(err)
+		return
+	}
+	for path, expected := range map[string]string{
+		filepath.Join(string(Memory), "test", "notify_on_release"):  "1",
+		filepath.Join(string(Freezer), "test", "notify_on_release"): "1",
+		filepath.Join(string(Memory), "release_agent"):              "/usr/bin/cleanup",
+	} {
+		v, err := readValue(mock, path)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if v != expected {
+			t.Errorf("expected %q in %s but received %q", expected, path, v)
+			return
+		}
+	}
+	if _, err := os.Stat(filepath.Join(mock.root, string(Freezer), "release_agent")); !os.IsNotExist(err) {
+		t.Errorf("expected release agent to only be set for memory but received %v", err)
+	}
+	if err := SetReleaseAgent(mock.hierarchy, "/usr/bin/cleanup"); err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := os.Stat(filepath.Join(mock.root, "systemd", "release_agent")); !os.IsNotExist(err) {
+		t.Errorf("expected release agent of the systemd hierarchy to be left untouched but received %v", err)
+	}
+}
+
+func TestDeleteKillFrozen(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Freeze(); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Delete(KillProcesses); err != ErrFrozen {
+		t.Errorf("expected ErrFrozen but received %v", err)
+	}
+}
+
+func TestFrozenOperations(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	other, err := New(mock.hierarchy, StaticPath("other"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Freeze(); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Add(Process{Pid: 1234}); err != ErrFrozen {
+		t.Errorf("expected ErrFrozen adding a process but received %v", err)
+		return
+	}
+	if err := control.Update(&specs.LinuxResources{Pids: &specs.LinuxPids{Limit: 10}}); err != nil {
+		t.Errorf("expected a frozen cgroup to be updated but received %v", err)
+		return
+	}
+	if _, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{}, WithExistOK()); err != nil {
+		t.Errorf("expected a frozen cgroup to be loaded but received %v", err)
+		return
+	}
+	if err := control.MoveTo(other); err != ErrFrozen {
+		t.Errorf("expected ErrFrozen moving from a frozen cgroup but received %v", err)
+		return
+	}
+	if err := other.MoveTo(control); err != ErrFrozen {
+		t.Errorf("expected ErrFrozen moving to a frozen cgroup but received %v", err)
+		return
+	}
+	if err := control.Thaw(); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Add(Process{Pid: 1234}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSingleSubsystemNotFound(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	if _, err := SingleSubsystem(mock.hierarchy, "unknown")(); err != ErrNoSuchSubsystem {
+		t.Errorf("expected ErrNoSuchSubsystem but received %v", err)
+	}
+}
+
+func TestOwnership(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("skipping test that requires root")
+	}
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{
+		Pids: &specs.LinuxPids{Limit: 10},
+	}, WithOwnership(1000, 1000, 0664))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := control.New("child", &specs.LinuxResources{}); err != nil {
+		t.Error(err)
+		return
+	}
+	// the cgroups returned by Load and Walk create their children with the
+	// same ownership
+	loaded, err := Load(mock.hierarchy, StaticPath("test"), WithOwnership(1000, 1000, 0664))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := loaded.New("loaded", &specs.LinuxResources{}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Walk(func(path string, cg Cgroup) error {
+		if path != "child" {
+			return nil
+		}
+		_, err := cg.New("walked", &specs.LinuxResources{})
+		return err
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	for _, path := range []string{
+		filepath.Join(string(Pids), "test"),
+		filepath.Join(string(Pids), "test", "pids.max"),
+		filepath.Join(string(Pids), "test", "child"),
+		filepath.Join(string(Pids), "test", "loaded"),
+		filepath.Join(string(Pids), "test", "child", "walked"),
+	} {
+		info, err := os.Stat(filepath.Join(mock.root, path))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		st := info.Sys().(*syscall.Stat_t)
+		if st.Uid != 1000 || st.Gid != 1000 {
+			t.Errorf("expected %s to be owned by 1000:1000 but received %d:%d", path, st.Uid, st.Gid)
+			return
+		}
+		if !info.IsDir() && info.Mode().Perm() != 0664 {
+			t.Errorf("expected %s to have mode 0664 but received %v", path, info.Mode())
+		}
+	}
+}
+
+func TestSkipUnmounted(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	if err := os.RemoveAll(filepath.Join(mock.root, string(Rdma))); err != nil {
+		t.Fatal(err)
+	}
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{}, WithSkipUnmounted(true))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if skipped := control.Skipped(); len(skipped) != 1 || skipped[0] != Rdma {
+		t.Errorf("expected rdma to be skipped but received %v", skipped)
+		return
+	}
+	if _, err := os.Stat(filepath.Join(mock.root, string(Rdma))); !os.IsNotExist(err) {
+		t.Errorf("expected rdma cgroup not to be created but received %v", err)
+		return
+	}
+	control, err = New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if skipped := control.Skipped(); len(skipped) != 0 {
+		t.Errorf("expected no skipped subsystems but received %v", skipped)
+	}
+}
+
+func TestCreateWithProcesses(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	if _, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{}, WithProcesses(Process{Pid: 1234})); err != nil {
+		t.Error(err)
+		return
+	}
+	for _, s := range Subsystems() {
+		if err := checkPid(mock, filepath.Join(string(s), "test"), 1234); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if _, err := New(mock.hierarchy, StaticPath("invalid"), &specs.LinuxResources{}, WithProcesses(Process{Pid: 1234}, Process{Pid: -1})); errors.Cause(err) != ErrInvalidPid {
+		t.Errorf("expected ErrInvalidPid but received %v", err)
+		return
+	}
+	for _, s := range Subsystems() {
+		if _, err := os.Stat(filepath.Join(mock.root, string(s), "invalid")); !os.IsNotExist(err) {
+			t.Errorf("expected the cgroup to be removed from %s but received %v", s, err)
+			return
+		}
+	}
+}
+
+type customController struct {
+	root    string
+	updated bool
+}
+
+func (c *customController) Name() Name {
+	return "custom"
+}
+
+func (c *customController) Path(path string) string {
+	return filepath.Join(c.root, path)
+}
+
+func (c *customController) Create(path string, resources *specs.LinuxResources) error {
+	return os.MkdirAll(c.Path(path), defaultDirPerm)
+}
+
+func (c *customController) Update(path string, resources *specs.LinuxResources) error {
+	c.updated = true
+	return nil
+}
+
+func (c *customController) Stat(path string, stats *Metrics) error {
+	return nil
+}
+
+func (c *customController) Delete(path string) error {
+	return os.RemoveAll(c.Path(path))
+}
+
+var _ Controller = &customController{}
+
+func TestCustomController(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	custom := &customController{root: filepath.Join(mock.root, "custom")}
+	if err := os.MkdirAll(custom.root, defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	control, err := New(AddSubsystems(mock.hierarchy, custom), StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Add(Process{Pid: 1234}); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := checkPid(mock, filepath.Join("custom", "test"), 1234); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Update(&specs.LinuxResources{}); err != nil {
+		t.Error(err)
+		return
+	}
+	if !custom.updated {
+		t.Error("expected custom controller to be updated")
+		return
+	}
+	if err := control.Delete(); err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := os.Stat(custom.Path("test")); !os.IsNotExist(err) {
+		t.Errorf("expected custom cgroup to be removed but received %v", err)
+	}
+}
+
+func TestReadWriteFile(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.WriteFile(Memory, "memory.swappiness", []byte("10")); err != nil {
+		t.Error(err)
+		return
+	}
+	data, err := control.ReadFile(Memory, "memory.swappiness")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(data) != "10" {
+		t.Errorf("expected %q but received %q", "10", data)
+		return
+	}
+	for _, file := range []string{"", ".", "..", "../memory.stat", "child/memory.stat"} {
+		if _, err := control.ReadFile(Memory, file); err != ErrInvalidFile {
+			t.Errorf("expected ErrInvalidFile for %q but received %v", file, err)
+		}
+	}
+	if _, err := control.ReadFile("unknown", "unknown.file"); err != ErrControllerNotActive {
+		t.Errorf("expected ErrControllerNotActive but received %v", err)
+	}
+}
+
+func TestExistOK(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	if _, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{
+		Pids: &specs.LinuxPids{
+			Limit: 10,
+		},
+	}); err != nil {
+		t.Error(err)
+		return
+	}
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{
+		Pids: &specs.LinuxPids{
+			Limit: 20,
+		},
+	}, WithExistOK())
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if control == nil {
+		t.Error("expected the existing cgroup to be loaded")
+		return
+	}
+	value, err := readValue(mock, filepath.Join("pids", "test", "pids.max"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if value != "20" {
+		t.Errorf("expected pids.max to be updated to %q but received %q", "20", value)
+		return
+	}
+	uid := os.Getuid() + 1
+	if _, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{}, WithExistOK(), WithOwnership(uid, uid, 0)); err != ErrInvalidOwnership {
+		t.Errorf("expected error %q but received %v", ErrInvalidOwnership, err)
+		return
+	}
+	if err := os.RemoveAll(filepath.Join(mock.root, string(Pids), "test")); err != nil {
+		t.Error(err)
+		return
+	}
+	if _, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{}, WithExistOK()); err != ErrCgroupIncomplete {
+		t.Errorf("expected error %q but received %v", ErrCgroupIncomplete, err)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{
+		Pids: &specs.LinuxPids{
+			Limit: 10,
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.Add(Process{Pid: 1234}); err != nil {
+		t.Error(err)
+		return
+	}
+	for _, v := range []struct {
+		subsystem Name
+		file      string
+		value     string
+	}{
+		{Memory, "memory.failcnt", "3"},
+		{Cpuacct, "cpuacct.usage", "123456789"},
+		{Freezer, "freezer.state", "FREEZING"},
+	} {
+		if err := ioutil.WriteFile(filepath.Join(mock.root, string(v.subsystem), "test", v.file), []byte(v.value), defaultFilePerm); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	snapshot, err := control.Snapshot()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var decoded Snapshot
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Error(err)
+		return
+	}
+	if v := decoded.Files[Pids]["pids.max"]; v != "10" {
+		t.Errorf("expected pids.max %q but received %q", "10", v)
+	}
+	for _, file := range []string{cgroupProcs, "memory.failcnt"} {
+		if _, ok := decoded.Files[Memory][file]; ok {
+			t.Errorf("expected %s to not be part of the snapshot", file)
+		}
+	}
+	if _, ok := decoded.Files[Cpuacct]["cpuacct.usage"]; ok {
+		t.Error("expected cpuacct.usage to not be part of the snapshot")
+	}
+	if _, ok := decoded.Files[Freezer]["freezer.state"]; ok {
+		t.Error("expected freezer.state to not be part of the snapshot")
+	}
+}
+
+func TestRestore(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	source, err := New(mock.hierarchy, StaticPath("source"), &specs.LinuxResources{
+		Pids: &specs.LinuxPids{
+			Limit: 10,
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	for file, value := range map[string]string{
+		"memory.oom_control":             "oom_kill_disable 1\nunder_oom 0\n",
+		"memory.swappiness":              "10",
+		"blkio.throttle.read_bps_device": "8:0 1024\n8:16 2048\n",
+		"devices.list":                   "c 1:3 rwm\nc 1:5 rwm\n",
+	} {
+		sub := Memory
+		switch {
+		case strings.HasPrefix(file, "blkio."):
+			sub = Blkio
+		case strings.HasPrefix(file, "devices."):
+			sub = Devices
+		}
+		if err := ioutil.WriteFile(filepath.Join(mock.root, string(sub), "source", file), []byte(value), defaultFilePerm); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	snapshot, err := source.Snapshot()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	destination, err := New(mock.hierarchy, StaticPath("destination"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	// the interface files exist in every new cgroup on a real system
+	for _, file := range []string{
+		filepath.Join("pids", "destination", "pids.max"),
+		filepath.Join("memory", "destination", "memory.oom_control"),
+		filepath.Join("memory", "destination", "memory.swappiness"),
+		filepath.Join("blkio", "destination", "blkio.throttle.read_bps_device"),
+		filepath.Join("devices", "destination", "devices.list"),
+		filepath.Join("devices", "destination", "devices.allow"),
+		filepath.Join("devices", "destination", "devices.deny"),
+	} {
+		if err := ioutil.WriteFile(filepath.Join(mock.root, file), nil, defaultFilePerm); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	if err := destination.Restore(snapshot); err != nil {
+		t.Error(err)
+		return
+	}
+	for file, expected := range map[string]string{
+		filepath.Join("pids", "destination", "pids.max"):                        "10",
+		filepath.Join("memory", "destination", "memory.swappiness"):             "10",
+		filepath.Join("memory", "destination", "memory.oom_control"):            "1",
+		filepath.Join("blkio", "destination", "blkio.throttle.read_bps_device"): "8:16 2048",
+		filepath.Join("devices", "destination", "devices.deny"):                 "a *:* rwm",
+		filepath.Join("devices", "destination", "devices.allow"):                "c 1:5 rwm",
+	} {
+		value, err := readValue(mock, file)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if value != expected {
+			t.Errorf("expected %s to be %q but received %q", file, expected, value)
+		}
+	}
+}
+
+func TestRestoreOrder(t *testing.T) {
+	order := restoreOrder(map[string]string{
+		"cpuset.cpu_exclusive":        "0",
+		"cpuset.mems":                 "0",
+		"cpuset.cpus":                 "0-1",
+		"memory.memsw.limit_in_bytes": "2048",
+		"memory.limit_in_bytes":       "1024",
+	})
+	expected := []string{"cpuset.cpus", "cpuset.mems", "memory.limit_in_bytes", "cpuset.cpu_exclusive", "memory.memsw.limit_in_bytes"}
+	for i, name := range order {
+		if name != expected[i] {
+			t.Errorf("expected %q at index %d but received %q", expected[i], i, name)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	var (
+		limit   = int64(1024)
+		classid = NetClassID(0x10, 0x1)
+	)
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{
+		Pids: &specs.LinuxPids{
+			Limit: 10,
+		},
+		Memory: &specs.LinuxMemory{
+			Limit: &limit,
+		},
+		CPU: &specs.LinuxCPU{
+			Cpus: "0,1",
+			Mems: "0",
+		},
+		Network: &specs.LinuxNetwork{
+			ClassID: &classid,
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var (
+		desired        = int64(2048)
+		shares         = uint64(512)
+		desiredClassID = NetClassID(0x10, 0x2)
+	)
+	changes, err := control.Diff(&specs.LinuxResources{
+		Pids: &specs.LinuxPids{
+			Limit: 10,
+		},
+		Memory: &specs.LinuxMemory{
+			Limit: &desired,
+		},
+		CPU: &specs.LinuxCPU{
+			Shares: &shares,
+			Cpus:   "0-1",
+			Mems:   "0",
+		},
+		Network: &specs.LinuxNetwork{
+			ClassID: &desiredClassID,
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := []Change{
+		{Subsystem: NetCLS, File: "net_cls.classid", Current: "1048577", Desired: "1048578"},
+		{Subsystem: Cpu, File: "cpu.shares", Current: "", Desired: "512"},
+		{Subsystem: Memory, File: "memory.limit_in_bytes", Current: "1024", Desired: "2048"},
+	}
+	if len(changes) != len(expected) {
+		t.Errorf("expected %d changes but received %+v", len(expected), changes)
+		return
+	}
+	for i, c := range changes {
+		if c != expected[i] {
+			t.Errorf("expected change %+v but received %+v", expected[i], c)
+		}
+	}
+	value, err := readValue(mock, filepath.Join("memory", "test", "memory.limit_in_bytes"))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if value != "1024" {
+		t.Errorf("expected Diff to not change the memory limit but received %q", value)
+		return
+	}
+	if changes, err := control.Diff(nil); err != nil || len(changes) != 0 {
+		t.Errorf("expected no changes for nil resources but received %+v, %v", changes, err)
+	}
+}
+
+func TestWithControllers(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{}, WithControllers(Cpu, Memory))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if l := len(control.Subsystems()); l != 2 {
+		t.Errorf("expected 2 subsystems but received %d", l)
+		return
+	}
+	for _, s := range mock.subsystems {
+		_, err := os.Stat(filepath.Join(mock.root, string(s.Name()), "test"))
+		if s.Name() == Cpu || s.Name() == Memory {
+			if err != nil {
+				t.Errorf("expected cgroup for %s: %v", s.Name(), err)
+			}
+			continue
+		}
+		if !os.IsNotExist(err) {
+			t.Errorf("expected no cgroup for %s but received %v", s.Name(), err)
+		}
+	}
+	loaded, err := Load(mock.hierarchy, StaticPath("test"), WithControllers(Memory))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if s := loaded.Subsystems(); len(s) != 1 || s[0].Name() != Memory {
+		t.Errorf("expected only the memory subsystem to be loaded")
+		return
+	}
+	if _, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{}, WithControllers(Memory, "memroy")); err != ErrNoSuchSubsystem {
+		t.Errorf("expected error %q but received %v", ErrNoSuchSubsystem, err)
+	}
+}
+
+func TestNetPriorities(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := control.SetNetPriorities(map[string]uint32{"lo": 3}); err != nil {
+		t.Error(err)
+		return
+	}
+	priorities, err := control.NetPriorities()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(priorities) != 1 || priorities["lo"] != 3 {
+		t.Errorf("unexpected priorities %v", priorities)
+	}
+}
+
+func TestCgroupNetClassID(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	classid := NetClassID(0x10, 0x1)
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{
+		Network: &specs.LinuxNetwork{
+			ClassID: &classid,
+		},
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	v, err := control.NetClassID()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if v != classid {
+		t.Errorf("expected class id %#x but received %#x", classid, v)
+	}
+}
@@ -17,6 +17,7 @@
 package cgroups
 
 import (
+	"context"
 	"os"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
@@ -58,31 +59,85 @@ type Task struct {
 type Cgroup interface {
 	// New creates a new cgroup under the calling cgroup
 	New(string, *specs.LinuxResources) (Cgroup, error)
-	// Add adds a process to the cgroup (cgroup.procs)
-	Add(Process) error
-	// AddTask adds a process to the cgroup (tasks)
-	AddTask(Process) error
-	// Delete removes the cgroup as a whole
-	Delete() error
+	// Add adds a process to the cgroup (cgroup.procs), optionally only in
+	// the provided subsystems
+	Add(Process, ...Name) error
+	// AddTask adds a process to the cgroup (tasks), optionally only in the
+	// provided subsystems
+	AddTask(Process, ...Name) error
+	// Delete removes the cgroup as a whole, optionally killing or moving
+	// the remaining processes first
+	Delete(...DeleteOpts) error
 	// MoveTo moves all the processes under the calling cgroup to the provided one
 	// subsystems are moved one at a time
 	MoveTo(Cgroup) error
+	// Walk calls the function for every child cgroup of the cgroup
+	Walk(func(string, Cgroup) error) error
 	// Stat returns the stats for all subsystems in the cgroup
 	Stat(...ErrorHandler) (*Metrics, error)
 	// Update updates all the subsystems with the provided resource changes
 	Update(resources *specs.LinuxResources) error
+	// Diff returns the settings that differ from the provided resources
+	Diff(resources *specs.LinuxResources) ([]Change, error)
 	// Processes returns all the processes in a select subsystem for the cgroup
 	Processes(Name, bool) ([]Process, error)
 	// Tasks returns all the tasks in a select subsystem for the cgroup
 	Tasks(Name, bool) ([]Task, error)
 	// Freeze freezes or pauses all processes inside the cgroup
 	Freeze() error
+	// FreezeContext freezes all processes inside the cgroup, giving up
+	// once the context is done
+	FreezeContext(context.Context) error
 	// Thaw thaw or resumes all processes inside the cgroup
 	Thaw() error
+	// SetCpusetExclusive toggles cpuset.cpu_exclusive and cpuset.mem_exclusive
+	SetCpusetExclusive(cpus, mems bool) error
+	// CpusetPlacement returns the effective cpuset placement of the cgroup
+	CpusetPlacement() (*CpusetPlacement, error)
 	// OOMEventFD returns the memory subsystem's event fd for OOM events
 	OOMEventFD() (uintptr, error)
+	// RegisterMemoryOOMEvent returns a channel notified on OOM events
+	RegisterMemoryOOMEvent() (<-chan struct{}, error)
+	// RegisterMemoryPressureEvent returns a channel notified on memory pressure
+	RegisterMemoryPressureEvent(MemoryPressureLevel, EventNotificationMode) (<-chan struct{}, error)
+	// RegisterMemoryThreshold returns a channel notified when memory usage
+	// crosses the provided threshold
+	RegisterMemoryThreshold(threshold uint64, swap bool) (<-chan struct{}, error)
+	// RegisterMemoryEvent returns a channel notified by the event registered
+	// for the memory file with the provided arguments
+	RegisterMemoryEvent(file, args string) (<-chan struct{}, error)
+	// SetMemoryOOMKillDisable toggles the oom killer for the cgroup
+	SetMemoryOOMKillDisable(bool) error
+	// RegisterPidsMaxEvent returns a channel notified when forks fail
+	// because the pids limit was reached
+	RegisterPidsMaxEvent() (<-chan uint64, error)
+	// AddDeviceRules adds allow or deny rules to the devices cgroup
+	AddDeviceRules(...DeviceRule) error
+	// DeviceRules returns the allowed device rules of the cgroup
+	DeviceRules() ([]DeviceRule, error)
+	// SetMiscLimits sets the maximum usage of misc resources
+	SetMiscLimits(map[string]uint64) error
+	// NetClassID returns the class id tagged on packets sent from the cgroup
+	NetClassID() (uint32, error)
+	// NetPriorities returns the network priority of each interface
+	NetPriorities() (map[string]uint32, error)
+	// SetNetPriorities sets the network priority of interfaces
+	SetNetPriorities(map[string]uint32) error
+	// SetNotifyOnRelease toggles running the release agent once the cgroup
+	// is empty
+	SetNotifyOnRelease(bool) error
+	// ReadFile reads a file of the cgroup in the subsystem
+	ReadFile(Name, string) ([]byte, error)
+	// WriteFile writes a file of the cgroup in the subsystem
+	WriteFile(Name, string, []byte) error
+	// Snapshot returns the values of the tunable files of every subsystem
+	Snapshot() (*Snapshot, error)
+	// Restore writes the values captured by Snapshot to the cgroup
+	Restore(*Snapshot) error
 	// State returns the cgroups current state
 	State() State
 	// Subsystems returns all the subsystems in the cgroup
 	Subsystems() []Subsystem
+	// Skipped returns the subsystems that are not mounted or active
+	Skipped() []Name
 }
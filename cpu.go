@@ -27,6 +27,14 @@ import (
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
+const (
+	// minCFSQuota is the smallest cfs quota accepted by the kernel (1ms)
+	minCFSQuota = 1000
+	// minCFSPeriod and maxCFSPeriod bound the cfs period (1ms to 1s)
+	minCFSPeriod = 1000
+	maxCFSPeriod = 1000000
+)
+
 func NewCpu(root string) *cpuController {
 	return &cpuController{
 		root: filepath.Join(root, string(Cpu)),
@@ -50,42 +58,17 @@ func (c *cpuController) Create(path string, resources *specs.LinuxResources) err
 		return err
 	}
 	if cpu := resources.CPU; cpu != nil {
-		for _, t := range []struct {
-			name   string
-			ivalue *int64
-			uvalue *uint64
-		}{
-			{
-				name:   "rt_period_us",
-				uvalue: cpu.RealtimePeriod,
-			},
-			{
-				name:   "rt_runtime_us",
-				ivalue: cpu.RealtimeRuntime,
-			},
-			{
-				name:   "shares",
-				uvalue: cpu.Shares,
-			},
-			{
-				name:   "cfs_period_us",
-				uvalue: cpu.Period,
-			},
-			{
-				name:   "cfs_quota_us",
-				ivalue: cpu.Quota,
-			},
-		} {
-			var value []byte
-			if t.uvalue != nil {
-				value = []byte(strconv.FormatUint(*t.uvalue, 10))
-			} else if t.ivalue != nil {
-				value = []byte(strconv.FormatInt(*t.ivalue, 10))
-			}
-			if value != nil {
+		if err := validateCFS(cpu); err != nil {
+			return err
+		}
+		for _, t := range getCPUSettings(cpu) {
+			if value := t.format(); value != "" {
+				if t.realtime && !c.hasFile(path, t.name) {
+					return ErrRealtimeNotSupported
+				}
 				if err := ioutil.WriteFile(
 					filepath.Join(c.Path(path), fmt.Sprintf("cpu.%s", t.name)),
-					value,
+					[]byte(value),
 					defaultFilePerm,
 				); err != nil {
 					return err
@@ -96,6 +79,83 @@ func (c *cpuController) Create(path string, resources *specs.LinuxResources) err
 	return nil
 }
 
+// Diff returns the cpu settings of the resources that differ from the cgroup
+func (c *cpuController) Diff(path string, resources *specs.LinuxResources) ([]Change, error) {
+	if resources.CPU == nil {
+		return nil, nil
+	}
+	var changes []Change
+	for _, t := range getCPUSettings(resources.CPU) {
+		if value := t.format(); value != "" {
+			change, err := diffFile(Cpu, c.Path(path), fmt.Sprintf("cpu.%s", t.name), value)
+			if err != nil {
+				return nil, err
+			}
+			if change != nil {
+				changes = append(changes, *change)
+			}
+		}
+	}
+	return changes, nil
+}
+
+type cpuSettings struct {
+	name     string
+	ivalue   *int64
+	uvalue   *uint64
+	realtime bool
+}
+
+// format returns the value to write or an empty string if it is not set
+func (t cpuSettings) format() string {
+	if t.uvalue != nil {
+		return strconv.FormatUint(*t.uvalue, 10)
+	} else if t.ivalue != nil {
+		return strconv.FormatInt(*t.ivalue, 10)
+	}
+	return ""
+}
+
+func getCPUSettings(cpu *specs.LinuxCPU) []cpuSettings {
+	quota := cpu.Quota
+	// a zero quota is the unset value of runtimes that do not use pointers
+	if quota != nil && *quota == 0 {
+		quota = nil
+	}
+	return []cpuSettings{
+		{
+			name:     "rt_period_us",
+			uvalue:   cpu.RealtimePeriod,
+			realtime: true,
+		},
+		{
+			name:     "rt_runtime_us",
+			ivalue:   cpu.RealtimeRuntime,
+			realtime: true,
+		},
+		{
+			name:   "shares",
+			uvalue: cpu.Shares,
+		},
+		{
+			name:   "cfs_period_us",
+			uvalue: cpu.Period,
+		},
+		{
+			name:   "cfs_quota_us",
+			ivalue: quota,
+		},
+	}
+}
+
+// hasFile returns true if the cpu.<name> interface file exists for the cgroup.
+// The realtime files are only available on kernels built with
+// CONFIG_RT_GROUP_SCHED.
+func (c *cpuController) hasFile(path, name string) bool {
+	_, err := os.Lstat(filepath.Join(c.Path(path), fmt.Sprintf("cpu.%s", name)))
+	return err == nil
+}
+
 func (c *cpuController) Update(path string, resources *specs.LinuxResources) error {
 	return c.Create(path, resources)
 }
@@ -127,3 +187,17 @@ func (c *cpuController) Stat(path string, stats *Metrics) error {
 	}
 	return nil
 }
+
+// validateCFS checks the cfs bandwidth settings before they are written so
+// that callers get a meaningful error instead of EINVAL. A quota of -1 is
+// valid and removes any existing limit on the cgroup, a quota of 0 is unset
+// and leaves the current limit untouched.
+func validateCFS(cpu *specs.LinuxCPU) error {
+	if cpu.Quota != nil && *cpu.Quota != -1 && *cpu.Quota != 0 && *cpu.Quota < minCFSQuota {
+		return ErrInvalidCPUQuota
+	}
+	if cpu.Period != nil && (*cpu.Period < minCFSPeriod || *cpu.Period > maxCFSPeriod) {
+		return ErrInvalidCPUPeriod
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestValidateCFS(t *testing.T) {
+	var (
+		unlimited = int64(-1)
+		unset     = int64(0)
+		tooSmall  = int64(999)
+		quota     = int64(50000)
+		period    = uint64(100000)
+		badPeriod = uint64(2000000)
+	)
+	for _, tt := range []struct {
+		cpu specs.LinuxCPU
+		err error
+	}{
+		{
+			cpu: specs.LinuxCPU{Quota: &quota, Period: &period},
+		},
+		{
+			cpu: specs.LinuxCPU{Quota: &unlimited},
+		},
+		{
+			cpu: specs.LinuxCPU{Quota: &unset, Period: &period},
+		},
+		{
+			cpu: specs.LinuxCPU{Quota: &tooSmall},
+			err: ErrInvalidCPUQuota,
+		},
+		{
+			cpu: specs.LinuxCPU{Period: &badPeriod},
+			err: ErrInvalidCPUPeriod,
+		},
+	} {
+		if err := validateCFS(&tt.cpu); err != tt.err {
+			t.Errorf("expected error %v but received %v", tt.err, err)
+		}
+	}
+}
+
+func TestCPUQuotaUnset(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	cpu := NewCpu(mock.root)
+	var (
+		quota  = int64(0)
+		period = uint64(100000)
+	)
+	if err := cpu.Create("test", &specs.LinuxResources{
+		CPU: &specs.LinuxCPU{
+			Quota:  &quota,
+			Period: &period,
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(cpu.Path("test"), "cpu.cfs_quota_us")); !os.IsNotExist(err) {
+		t.Fatalf("expected the quota to not be written but received %v", err)
+	}
+}
+
+func TestCPURealtimeNotSupported(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	cpu := NewCpu(mock.root)
+	runtime := int64(950000)
+	err = cpu.Create("test", &specs.LinuxResources{
+		CPU: &specs.LinuxCPU{
+			RealtimeRuntime: &runtime,
+		},
+	})
+	if err != ErrRealtimeNotSupported {
+		t.Fatalf("expected error %q but received %v", ErrRealtimeNotSupported, err)
+	}
+}
+
+func TestCPUStatThrottling(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	cpu := NewCpu(mock.root)
+	if err := os.MkdirAll(cpu.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	const data = "nr_periods 10\nnr_throttled 4\nthrottled_time 2000\n"
+	if err := ioutil.WriteFile(filepath.Join(cpu.Path("test"), "cpu.stat"), []byte(data), defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	metrics := Metrics{
+		CPU: &CPUStat{
+			Throttling: &Throttle{},
+		},
+	}
+	if err := cpu.Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	expected := Throttle{
+		Periods:          10,
+		ThrottledPeriods: 4,
+		ThrottledTime:    2000,
+	}
+	if *metrics.CPU.Throttling != expected {
+		t.Fatalf("expected throttling %+v but received %+v", expected, *metrics.CPU.Throttling)
+	}
+}
@@ -0,0 +1,78 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCpuacctStat(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	cpuacct := NewCpuacct(mock.root)
+	if err := os.MkdirAll(cpuacct.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range []struct {
+		name  string
+		value string
+	}{
+		{
+			name:  "cpuacct.usage",
+			value: "3000\n",
+		},
+		{
+			name:  "cpuacct.usage_percpu",
+			value: "1000 2000 \n",
+		},
+		{
+			name:  "cpuacct.stat",
+			value: "user 10\nsystem 20\n",
+		},
+	} {
+		if err := ioutil.WriteFile(filepath.Join(cpuacct.Path("test"), f.name), []byte(f.value), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	metrics := Metrics{
+		CPU: &CPUStat{
+			Usage: &CPUUsage{},
+		},
+	}
+	if err := cpuacct.Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	usage := metrics.CPU.Usage
+	if usage.Total != 3000 {
+		t.Errorf("expected total usage 3000 but received %d", usage.Total)
+	}
+	if len(usage.PerCPU) != 2 || usage.PerCPU[0] != 1000 || usage.PerCPU[1] != 2000 {
+		t.Errorf("expected per cpu usage [1000 2000] but received %v", usage.PerCPU)
+	}
+	if expected := 10 * nanosecondsInSecond / clockTicks; usage.User != expected {
+		t.Errorf("expected user usage %d but received %d", expected, usage.User)
+	}
+	if expected := 20 * nanosecondsInSecond / clockTicks; usage.Kernel != expected {
+		t.Errorf("expected kernel usage %d but received %d", expected, usage.Kernel)
+	}
+}
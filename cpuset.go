@@ -22,10 +22,26 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
+// CpusetPlacement is the cpu and memory node placement that the kernel
+// computed for a cpuset cgroup
+type CpusetPlacement struct {
+	// EffectiveCpus are the cpus the cgroup's tasks are allowed to run on
+	EffectiveCpus string
+	// EffectiveMems are the memory nodes the cgroup's tasks may allocate on
+	EffectiveMems string
+	// MemoryMigrate is true if pages are moved when mems change
+	MemoryMigrate bool
+	// MemorySpreadPage is true if the page cache is spread over the mems
+	MemorySpreadPage bool
+	// MemorySpreadSlab is true if slab caches are spread over the mems
+	MemorySpreadSlab bool
+}
+
 func NewCputset(root string) *cpusetController {
 	return &cpusetController{
 		root: filepath.Join(root, string(Cpuset)),
@@ -86,6 +102,144 @@ func (c *cpusetController) Update(path string, resources *specs.LinuxResources)
 	return c.Create(path, resources)
 }
 
+// Diff returns the cpus and mems of the resources that differ from the cgroup.
+// The values are compared as written, "0,1" is reported to differ from the
+// "0-1" the kernel lists.
+func (c *cpusetController) Diff(path string, resources *specs.LinuxResources) ([]Change, error) {
+	if resources.CPU == nil {
+		return nil, nil
+	}
+	var changes []Change
+	for _, t := range []struct {
+		name  string
+		value string
+	}{
+		{
+			name:  "cpus",
+			value: resources.CPU.Cpus,
+		},
+		{
+			name:  "mems",
+			value: resources.CPU.Mems,
+		},
+	} {
+		if t.value == "" {
+			continue
+		}
+		change, err := diffFile(Cpuset, c.Path(path), fmt.Sprintf("cpuset.%s", t.name), t.value)
+		if err != nil {
+			return nil, err
+		}
+		// the kernel lists ranges, "0,1" is read back as "0-1"
+		if change != nil && !equalCPUSets(change.Current, change.Desired) {
+			changes = append(changes, *change)
+		}
+	}
+	return changes, nil
+}
+
+// SetCloneChildren toggles cgroup.clone_children so that child cgroups
+// created afterwards start with the cpus and mems of the cgroup
+func (c *cpusetController) SetCloneChildren(path string, enabled bool) error {
+	value := "0"
+	if enabled {
+		value = "1"
+	}
+	return ioutil.WriteFile(
+		filepath.Join(c.Path(path), "cgroup.clone_children"),
+		[]byte(value),
+		defaultFilePerm,
+	)
+}
+
+// SetExclusive toggles cpuset.cpu_exclusive and cpuset.mem_exclusive for the
+// cgroup. The kernel rejects exclusivity if the parent is not exclusive or a
+// sibling shares the same cpus or mems.
+func (c *cpusetController) SetExclusive(path string, cpus, mems bool) error {
+	for _, t := range []struct {
+		name  string
+		value bool
+	}{
+		{
+			name:  "cpu_exclusive",
+			value: cpus,
+		},
+		{
+			name:  "mem_exclusive",
+			value: mems,
+		},
+	} {
+		value := "0"
+		if t.value {
+			value = "1"
+		}
+		if err := ioutil.WriteFile(
+			filepath.Join(c.Path(path), fmt.Sprintf("cpuset.%s", t.name)),
+			[]byte(value),
+			defaultFilePerm,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Placement returns the effective cpus and mems of the cgroup along with its
+// memory migration and spreading flags. Kernels older than 4.4 do not provide
+// the effective files, the configured cpus and mems are returned instead.
+func (c *cpusetController) Placement(path string) (*CpusetPlacement, error) {
+	var p CpusetPlacement
+	for _, t := range []struct {
+		name     string
+		fallback string
+		value    *string
+	}{
+		{
+			name:     "effective_cpus",
+			fallback: "cpus",
+			value:    &p.EffectiveCpus,
+		},
+		{
+			name:     "effective_mems",
+			fallback: "mems",
+			value:    &p.EffectiveMems,
+		},
+	} {
+		data, err := ioutil.ReadFile(filepath.Join(c.Path(path), fmt.Sprintf("cpuset.%s", t.name)))
+		if os.IsNotExist(err) {
+			data, err = ioutil.ReadFile(filepath.Join(c.Path(path), fmt.Sprintf("cpuset.%s", t.fallback)))
+		}
+		if err != nil {
+			return nil, err
+		}
+		*t.value = strings.TrimSpace(string(data))
+	}
+	for _, t := range []struct {
+		name  string
+		value *bool
+	}{
+		{
+			name:  "memory_migrate",
+			value: &p.MemoryMigrate,
+		},
+		{
+			name:  "memory_spread_page",
+			value: &p.MemorySpreadPage,
+		},
+		{
+			name:  "memory_spread_slab",
+			value: &p.MemorySpreadSlab,
+		},
+	} {
+		v, err := readUint(filepath.Join(c.Path(path), fmt.Sprintf("cpuset.%s", t.name)))
+		if err != nil {
+			return nil, err
+		}
+		*t.value = v == 1
+	}
+	return &p, nil
+}
+
 func (c *cpusetController) getValues(path string) (cpus []byte, mems []byte, err error) {
 	if cpus, err = ioutil.ReadFile(filepath.Join(path, "cpuset.cpus")); err != nil && !os.IsNotExist(err) {
 		return
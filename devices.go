@@ -21,6 +21,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -28,9 +30,12 @@ import (
 const (
 	allowDeviceFile = "devices.allow"
 	denyDeviceFile  = "devices.deny"
-	wildcard        = -1
+	listDeviceFile  = "devices.list"
 )
 
+// DeviceWildcard is the Major or Minor of a DeviceRule matching any number
+const DeviceWildcard int64 = -1
+
 func NewDevices(root string) *devicesController {
 	return &devicesController{
 		root: filepath.Join(root, string(Devices)),
@@ -54,18 +59,7 @@ func (d *devicesController) Create(path string, resources *specs.LinuxResources)
 		return err
 	}
 	for _, device := range resources.Devices {
-		file := denyDeviceFile
-		if device.Allow {
-			file = allowDeviceFile
-		}
-		if device.Type == "" {
-			device.Type = "a"
-		}
-		if err := ioutil.WriteFile(
-			filepath.Join(d.Path(path), file),
-			[]byte(deviceString(device)),
-			defaultFilePerm,
-		); err != nil {
+		if err := d.AddRule(path, newDeviceRule(device)); err != nil {
 			return err
 		}
 	}
@@ -76,18 +70,150 @@ func (d *devicesController) Update(path string, resources *specs.LinuxResources)
 	return d.Create(path, resources)
 }
 
-func deviceString(device specs.LinuxDeviceCgroup) string {
-	return fmt.Sprintf("%s %s:%s %s",
-		device.Type,
-		deviceNumber(device.Major),
-		deviceNumber(device.Minor),
-		device.Access,
+// DeviceRule is a single entry of the devices cgroup access lists
+type DeviceRule struct {
+	// Type is "a" (all), "c" (char) or "b" (block)
+	Type string
+	// Major and Minor are the device numbers, DeviceWildcard matches any
+	// number
+	Major int64
+	Minor int64
+	// Access is a combination of "r" (read), "w" (write) and "m" (mknod)
+	Access string
+	// Allow writes the rule to devices.allow instead of devices.deny
+	Allow bool
+}
+
+func (r DeviceRule) String() string {
+	return fmt.Sprintf("%s %s:%s %s", r.Type, deviceNumber(r.Major), deviceNumber(r.Minor), r.Access)
+}
+
+// Validate returns ErrInvalidDeviceRule if the rule cannot be written to the
+// access lists, such as the zero value which has no type or access
+func (r DeviceRule) Validate() error {
+	switch r.Type {
+	case "a", "b", "c":
+	default:
+		return ErrInvalidDeviceRule
+	}
+	if r.Major < DeviceWildcard || r.Minor < DeviceWildcard {
+		return ErrInvalidDeviceRule
+	}
+	if r.Access == "" || len(r.Access) > 3 {
+		return ErrInvalidDeviceRule
+	}
+	for i, c := range r.Access {
+		if !strings.ContainsRune("rwm", c) || strings.ContainsRune(r.Access[i+1:], c) {
+			return ErrInvalidDeviceRule
+		}
+	}
+	return nil
+}
+
+// newDeviceRule returns the rule of the runtime spec device, an unset type
+// means all devices and an unset access means "rwm"
+func newDeviceRule(device specs.LinuxDeviceCgroup) DeviceRule {
+	rule := DeviceRule{
+		Type:   device.Type,
+		Major:  DeviceWildcard,
+		Minor:  DeviceWildcard,
+		Access: device.Access,
+		Allow:  device.Allow,
+	}
+	if rule.Type == "" {
+		rule.Type = "a"
+	}
+	if rule.Access == "" {
+		rule.Access = "rwm"
+	}
+	if device.Major != nil {
+		rule.Major = *device.Major
+	}
+	if device.Minor != nil {
+		rule.Minor = *device.Minor
+	}
+	return rule
+}
+
+// AddRule writes the rule to devices.allow or devices.deny
+func (d *devicesController) AddRule(path string, rule DeviceRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	file := denyDeviceFile
+	if rule.Allow {
+		file = allowDeviceFile
+	}
+	return ioutil.WriteFile(
+		filepath.Join(d.Path(path), file),
+		[]byte(rule.String()),
+		defaultFilePerm,
 	)
 }
 
-func deviceNumber(number *int64) string {
-	if number == nil || *number == wildcard {
+// Rules returns the rules listed in devices.list, which are all allowed.
+// A cgroup with unrestricted device access reports the single rule "a *:* rwm".
+func (d *devicesController) Rules(path string) ([]DeviceRule, error) {
+	data, err := ioutil.ReadFile(filepath.Join(d.Path(path), listDeviceFile))
+	if err != nil {
+		return nil, err
+	}
+	var rules []DeviceRule
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		rule, err := parseDeviceRule(line)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// parseDeviceRule parses an entry of devices.list such as "c 1:3 rwm"
+func parseDeviceRule(s string) (DeviceRule, error) {
+	parts := strings.Fields(s)
+	if len(parts) != 3 {
+		return DeviceRule{}, fmt.Errorf("invalid device rule %q", s)
+	}
+	numbers := strings.Split(parts[1], ":")
+	if len(numbers) != 2 {
+		return DeviceRule{}, fmt.Errorf("invalid device rule %q", s)
+	}
+	major, err := parseDeviceNumber(numbers[0])
+	if err != nil {
+		return DeviceRule{}, err
+	}
+	minor, err := parseDeviceNumber(numbers[1])
+	if err != nil {
+		return DeviceRule{}, err
+	}
+	switch parts[0] {
+	case "a", "b", "c":
+	default:
+		return DeviceRule{}, fmt.Errorf("invalid device type in rule %q", s)
+	}
+	return DeviceRule{
+		Type:   parts[0],
+		Major:  major,
+		Minor:  minor,
+		Access: parts[2],
+		Allow:  true,
+	}, nil
+}
+
+func parseDeviceNumber(s string) (int64, error) {
+	if s == "*" {
+		return DeviceWildcard, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func deviceNumber(number int64) string {
+	if number == DeviceWildcard {
 		return "*"
 	}
-	return fmt.Sprint(*number)
+	return fmt.Sprint(number)
 }
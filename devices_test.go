@@ -0,0 +1,114 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestDeviceRules(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	devices := NewDevices(mock.root)
+	major := int64(1)
+	if err := devices.Create("test", &specs.LinuxResources{
+		Devices: []specs.LinuxDeviceCgroup{
+			{Allow: true, Type: "c", Major: &major, Access: "rwm"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkDeviceFile(devices.Path("test"), allowDeviceFile, "c 1:* rwm"); err != nil {
+		t.Fatal(err)
+	}
+	if err := devices.AddRule("test", DeviceRule{Type: "a", Major: DeviceWildcard, Minor: DeviceWildcard, Access: "rwm"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkDeviceFile(devices.Path("test"), denyDeviceFile, "a *:* rwm"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(
+		filepath.Join(devices.Path("test"), listDeviceFile),
+		[]byte("c 1:3 rwm\nb 8:* r\na *:* m\n"),
+		defaultFilePerm,
+	); err != nil {
+		t.Fatal(err)
+	}
+	rules, err := devices.Rules("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []DeviceRule{
+		{Type: "c", Major: 1, Minor: 3, Access: "rwm", Allow: true},
+		{Type: "b", Major: 8, Minor: DeviceWildcard, Access: "r", Allow: true},
+		{Type: "a", Major: DeviceWildcard, Minor: DeviceWildcard, Access: "m", Allow: true},
+	}
+	if len(rules) != len(expected) {
+		t.Fatalf("expected %d rules but received %d", len(expected), len(rules))
+	}
+	for i, rule := range rules {
+		if rule != expected[i] {
+			t.Errorf("expected rule %+v but received %+v", expected[i], rule)
+		}
+	}
+}
+
+func TestDeviceRuleValidate(t *testing.T) {
+	for _, rule := range []DeviceRule{
+		{},
+		{Type: "a", Major: DeviceWildcard, Minor: DeviceWildcard},
+		{Type: "x", Major: 1, Minor: 3, Access: "rwm"},
+		{Type: "c", Major: -2, Minor: 3, Access: "rwm"},
+		{Type: "c", Major: 1, Minor: 3, Access: "rx"},
+		{Type: "c", Major: 1, Minor: 3, Access: "rr"},
+	} {
+		if err := rule.Validate(); err != ErrInvalidDeviceRule {
+			t.Errorf("expected error %q for rule %+v but received %v", ErrInvalidDeviceRule, rule, err)
+		}
+	}
+	if err := (DeviceRule{Type: "c", Major: 1, Minor: DeviceWildcard, Access: "mr"}).Validate(); err != nil {
+		t.Errorf("expected rule to be valid but received %v", err)
+	}
+}
+
+func TestParseDeviceRuleInvalid(t *testing.T) {
+	for _, s := range []string{"", "c 1:3", "x 1:3 rwm", "c 1 rwm", "c a:3 rwm"} {
+		if _, err := parseDeviceRule(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
+
+func checkDeviceFile(path, file, expected string) error {
+	data, err := ioutil.ReadFile(filepath.Join(path, file))
+	if err != nil {
+		return err
+	}
+	if string(data) != expected {
+		return fmt.Errorf("expected %q in %s but received %q", expected, file, data)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Change is a setting whose value in the cgroup differs from the desired
+// resources, as returned by Diff
+type Change struct {
+	Subsystem Name
+	// File is the interface file of the setting, such as memory.limit_in_bytes
+	File    string
+	Current string
+	Desired string
+}
+
+// diffFile returns a change if the content of the file in the directory is not
+// the desired value, a missing file is reported with an empty current value
+func diffFile(name Name, dir, file, desired string) (*Change, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, file))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	current := strings.TrimSpace(string(data))
+	if current == desired {
+		return nil, nil
+	}
+	return &Change{
+		Subsystem: name,
+		File:      file,
+		Current:   current,
+		Desired:   desired,
+	}, nil
+}
+
+// parseCPUSet parses a list of cpus or memory nodes such as "0-2,4" into the
+// set of their ids
+func parseCPUSet(s string) (map[int]bool, error) {
+	set := make(map[int]bool)
+	for _, r := range strings.Split(strings.TrimSpace(s), ",") {
+		if r == "" {
+			continue
+		}
+		bounds := strings.SplitN(r, "-", 2)
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if len(bounds) == 2 {
+			if end, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, err
+			}
+		}
+		if end < start {
+			return nil, ErrInvalidFormat
+		}
+		for i := start; i <= end; i++ {
+			set[i] = true
+		}
+	}
+	return set, nil
+}
+
+// equalCPUSets returns true if both lists hold the same ids, such as "0,1"
+// and "0-1"
+func equalCPUSets(a, b string) bool {
+	x, err := parseCPUSet(a)
+	if err != nil {
+		return false
+	}
+	y, err := parseCPUSet(b)
+	if err != nil || len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if !y[i] {
+			return false
+		}
+	}
+	return true
+}
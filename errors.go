@@ -18,19 +18,98 @@ package cgroups
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"sort"
 )
 
 var (
 	ErrInvalidPid               = errors.New("cgroups: pid must be greater than 0")
 	ErrMountPointNotExist       = errors.New("cgroups: cgroup mountpoint does not exist")
 	ErrInvalidFormat            = errors.New("cgroups: parsing file with invalid format failed")
+	ErrInvalidFile              = errors.New("cgroups: invalid cgroup file name")
+	ErrInvalidPath              = errors.New("cgroups: invalid cgroup path")
+	ErrInvalidSlice             = errors.New("cgroups: invalid systemd slice name")
 	ErrFreezerNotSupported      = errors.New("cgroups: freezer cgroup not supported on this system")
+	ErrFreezerTimeout           = errors.New("cgroups: timed out waiting for freezer state")
 	ErrMemoryNotSupported       = errors.New("cgroups: memory cgroup not supported on this system")
+	ErrCpusetNotSupported       = errors.New("cgroups: cpuset cgroup not supported on this system")
+	ErrPidsNotSupported         = errors.New("cgroups: pids cgroup not supported on this system")
+	ErrDevicesNotSupported      = errors.New("cgroups: devices cgroup not supported on this system")
+	ErrInvalidDeviceRule        = errors.New("cgroups: device rule needs a type of a, b or c and an access of r, w and m")
+	ErrMiscNotSupported         = errors.New("cgroups: misc cgroup not supported on this system")
+	ErrNetClsNotSupported       = errors.New("cgroups: net_cls cgroup not supported on this system")
+	ErrNetPrioNotSupported      = errors.New("cgroups: net_prio cgroup not supported on this system")
+	ErrKernelMemoryNotSupported = errors.New("cgroups: kernel memory accounting not supported on this system")
+	ErrSwapNotSupported         = errors.New("cgroups: swap accounting not supported on this system")
+	ErrInvalidSwapLimit         = errors.New("cgroups: memory+swap limit must be at least the memory limit")
+	ErrInvalidPressureEvent     = errors.New("cgroups: memory pressure level must be low, medium or critical and mode local or hierarchy")
 	ErrCgroupDeleted            = errors.New("cgroups: cgroup deleted")
+	ErrCgroupIncomplete         = errors.New("cgroups: cgroup only exists in some subsystems")
+	ErrNoSuchSubsystem          = errors.New("cgroups: subsystem not found in hierarchy")
+	ErrInvalidOwnership         = errors.New("cgroups: cgroup is owned by another user or group")
+	ErrFrozen                   = errors.New("cgroups: cgroup is frozen")
+	ErrProcessNotExist          = errors.New("cgroups: process does not exist")
+	ErrProcessMoved             = errors.New("cgroups: process was moved to another cgroup")
 	ErrNoCgroupMountDestination = errors.New("cgroups: cannot find cgroup mount destination")
+	ErrInvalidCPUQuota          = errors.New("cgroups: cpu quota must be -1 or at least 1ms")
+	ErrInvalidCPUPeriod         = errors.New("cgroups: cpu period must be between 1ms and 1s")
+	ErrRealtimeNotSupported     = errors.New("cgroups: cpu realtime scheduling not supported on this system")
 )
 
+// MoveError is returned by MoveTo when some processes could not be moved to
+// the destination cgroup
+type MoveError struct {
+	// Failed holds the error of each pid that could not be moved
+	Failed map[int]error
+}
+
+func (e *MoveError) Error() string {
+	return fmt.Sprintf("cgroups: unable to move %d processes", len(e.Failed))
+}
+
+// Unwrap returns the error of each pid that could not be moved, ordered by
+// pid, so errors.Is and errors.As match any of them
+func (e *MoveError) Unwrap() []error {
+	pids := make([]int, 0, len(e.Failed))
+	for pid := range e.Failed {
+		pids = append(pids, pid)
+	}
+	sort.Ints(pids)
+	errs := make([]error, 0, len(pids))
+	for _, pid := range pids {
+		errs = append(errs, e.Failed[pid])
+	}
+	return errs
+}
+
+// FreezeError is returned when a cgroup could not be frozen before the
+// timeout or the context was done
+type FreezeError struct {
+	// Err is the reason freezing was stopped
+	Err error
+	// Stuck holds the tasks in uninterruptible sleep that prevented the
+	// cgroup from being frozen
+	Stuck []int
+}
+
+func (e *FreezeError) Error() string {
+	if len(e.Stuck) == 0 {
+		return fmt.Sprintf("cgroups: unable to freeze: %v", e.Err)
+	}
+	return fmt.Sprintf("cgroups: unable to freeze: %v: tasks %v in uninterruptible sleep", e.Err, e.Stuck)
+}
+
+// Cause returns the reason freezing was stopped
+func (e *FreezeError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the reason freezing was stopped
+func (e *FreezeError) Unwrap() error {
+	return e.Err
+}
+
 // ErrorHandler is a function that handles and acts on errors
 type ErrorHandler func(err error) error
 
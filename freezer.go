@@ -17,7 +17,11 @@
 package cgroups
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -42,11 +46,117 @@ func (f *freezerController) Path(path string) string {
 }
 
 func (f *freezerController) Freeze(path string) error {
-	return f.waitState(path, Frozen)
+	return f.FreezeTimeout(path, 0)
 }
 
 func (f *freezerController) Thaw(path string) error {
-	return f.waitState(path, Thawed)
+	return f.ThawTimeout(path, 0)
+}
+
+// FreezeTimeout freezes the cgroup and waits for it to leave the transient
+// FREEZING state. If the cgroup is not frozen within the timeout it is thawed
+// again and a *FreezeError caused by ErrFreezerTimeout is returned. A zero
+// timeout waits forever.
+func (f *freezerController) FreezeTimeout(path string, timeout time.Duration) error {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+	err := f.FreezeContext(ctx, path)
+	if ferr, ok := err.(*FreezeError); ok && ferr.Err == context.DeadlineExceeded {
+		ferr.Err = ErrFreezerTimeout
+	}
+	return err
+}
+
+// FreezeContext freezes the cgroup and waits for it to leave the transient
+// FREEZING state. If the context is done first the cgroup is thawed again and
+// a *FreezeError reporting the tasks in uninterruptible sleep, which prevent
+// the cgroup from being frozen, is returned.
+func (f *freezerController) FreezeContext(ctx context.Context, path string) error {
+	err := f.waitState(ctx, path, Frozen)
+	if err == nil || (err != context.Canceled && err != context.DeadlineExceeded) {
+		return err
+	}
+	// the stuck tasks are collected before thawing, the cgroup is thawed
+	// even when they cannot be read so it is not left FREEZING
+	stuck, serr := f.stuckTasks(path)
+	if terr := f.changeState(path, Thawed); terr != nil {
+		return terr
+	}
+	if serr != nil {
+		return serr
+	}
+	return &FreezeError{
+		Err:   err,
+		Stuck: stuck,
+	}
+}
+
+// ThawTimeout thaws the cgroup and waits until it reports THAWED. A zero
+// timeout waits forever.
+func (f *freezerController) ThawTimeout(path string, timeout time.Duration) error {
+	ctx, cancel := timeoutContext(timeout)
+	defer cancel()
+	if err := f.waitState(ctx, path, Thawed); err != nil {
+		if err == context.DeadlineExceeded {
+			return ErrFreezerTimeout
+		}
+		return err
+	}
+	return nil
+}
+
+// stuckTasks returns the tasks of the cgroup and its children that are in
+// uninterruptible sleep (D state)
+func (f *freezerController) stuckTasks(path string) ([]int, error) {
+	var stuck []int
+	err := filepath.Walk(f.Path(path), func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if info.Name() != cgroupTasks {
+			return nil
+		}
+		tasks, err := readTasksPids(filepath.Dir(p), Freezer)
+		if err != nil {
+			return err
+		}
+		for _, t := range tasks {
+			if taskState(t.Pid) == 'D' {
+				stuck = append(stuck, t.Pid)
+			}
+		}
+		return nil
+	})
+	return stuck, err
+}
+
+// taskState returns the state of the task from /proc/<pid>/stat or 0 if it
+// cannot be read
+func taskState(pid int) byte {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0
+	}
+	// the command may contain spaces and parentheses, the state follows the
+	// last closing parenthesis
+	i := bytes.LastIndexByte(data, ')')
+	if i < 0 || i+2 >= len(data) {
+		return 0
+	}
+	return data[i+2]
+}
+
+func timeoutContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout > 0 {
+		return context.WithTimeout(context.Background(), timeout)
+	}
+	return context.WithCancel(context.Background())
 }
 
 func (f *freezerController) changeState(path string, state State) error {
@@ -65,8 +175,10 @@ func (f *freezerController) state(path string) (State, error) {
 	return State(strings.ToLower(strings.TrimSpace(string(current)))), nil
 }
 
-func (f *freezerController) waitState(path string, state State) error {
+func (f *freezerController) waitState(ctx context.Context, path string, state State) error {
 	for {
+		// writing the state again while FREEZING retries freezing the
+		// tasks that could not be frozen yet
 		if err := f.changeState(path, state); err != nil {
 			return err
 		}
@@ -77,6 +189,10 @@ func (f *freezerController) waitState(path string, state State) error {
 		if current == state {
 			return nil
 		}
-		time.Sleep(1 * time.Millisecond)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(1 * time.Millisecond):
+		}
 	}
 }
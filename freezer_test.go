@@ -0,0 +1,57 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFreezeTimeout(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	freezer := NewFreezer(mock.root)
+	if err := os.MkdirAll(freezer.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	// a state file that never reports the written state
+	if err := os.Symlink(os.DevNull, filepath.Join(freezer.Path("test"), "freezer.state")); err != nil {
+		t.Fatal(err)
+	}
+	err = freezer.FreezeTimeout("test", 10*time.Millisecond)
+	if _, ok := err.(*FreezeError); !ok || !errors.Is(err, ErrFreezerTimeout) {
+		t.Fatalf("expected *FreezeError caused by ErrFreezerTimeout but received %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := freezer.FreezeContext(ctx, "test"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled but received %v", err)
+	}
+}
+
+func TestTaskState(t *testing.T) {
+	if state := taskState(os.Getpid()); state != 'R' && state != 'S' {
+		t.Fatalf("expected the test process to be running or sleeping but received %q", state)
+	}
+}
@@ -16,5 +16,34 @@
 
 package cgroups
 
+import (
+	"io/ioutil"
+	"path/filepath"
+)
+
 // Hierarchy enableds both unified and split hierarchy for cgroups
 type Hierarchy func() ([]Subsystem, error)
+
+// SetReleaseAgent writes the release agent to the root of every controller in
+// the hierarchy. The agent is run with the path of a cgroup that has
+// notify_on_release enabled once it becomes empty. Named hierarchies, such as
+// systemd, are skipped as their release agent belongs to the init system.
+func SetReleaseAgent(hierarchy Hierarchy, agent string) error {
+	subsystems, err := hierarchy()
+	if err != nil {
+		return err
+	}
+	for _, s := range pathers(subsystems) {
+		if _, ok := s.(*namedController); ok {
+			continue
+		}
+		if err := ioutil.WriteFile(
+			filepath.Join(s.Path("/"), "release_agent"),
+			[]byte(agent),
+			defaultFilePerm,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
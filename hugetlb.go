@@ -67,6 +67,24 @@ func (h *hugetlbController) Create(path string, resources *specs.LinuxResources)
 	return nil
 }
 
+func (h *hugetlbController) Update(path string, resources *specs.LinuxResources) error {
+	return h.Create(path, resources)
+}
+
+func (h *hugetlbController) Diff(path string, resources *specs.LinuxResources) ([]Change, error) {
+	var changes []Change
+	for _, limit := range resources.HugepageLimits {
+		change, err := diffFile(Hugetlb, h.Path(path), strings.Join([]string{"hugetlb", limit.Pagesize, "limit_in_bytes"}, "."), strconv.FormatUint(limit.Limit, 10))
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			changes = append(changes, *change)
+		}
+	}
+	return changes, nil
+}
+
 func (h *hugetlbController) Stat(path string, stats *Metrics) error {
 	for _, size := range h.sizes {
 		s, err := h.readSizeStat(path, size)
@@ -98,6 +116,10 @@ func (h *hugetlbController) readSizeStat(path, size string) (*HugetlbStat, error
 			name:  "failcnt",
 			value: &s.Failcnt,
 		},
+		{
+			name:  "limit_in_bytes",
+			value: &s.Limit,
+		},
 	} {
 		v, err := readUint(filepath.Join(h.Path(path), strings.Join([]string{"hugetlb", size, t.name}, ".")))
 		if err != nil {
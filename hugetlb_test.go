@@ -0,0 +1,75 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestHugetlbStat(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	hugetlb := &hugetlbController{
+		root:  filepath.Join(mock.root, string(Hugetlb)),
+		sizes: []string{"2MB", "1GB"},
+	}
+	if err := hugetlb.Create("test", &specs.LinuxResources{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, size := range hugetlb.sizes {
+		for _, f := range []string{"usage_in_bytes", "max_usage_in_bytes", "failcnt", "limit_in_bytes"} {
+			if err := ioutil.WriteFile(
+				filepath.Join(hugetlb.Path("test"), strings.Join([]string{"hugetlb", size, f}, ".")),
+				[]byte("0"),
+				defaultFilePerm,
+			); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := hugetlb.Update("test", &specs.LinuxResources{
+		HugepageLimits: []specs.LinuxHugepageLimit{
+			{Pagesize: "2MB", Limit: 4194304},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	var metrics Metrics
+	if err := hugetlb.Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	if len(metrics.Hugetlb) != 2 {
+		t.Fatalf("expected 2 page sizes but received %d", len(metrics.Hugetlb))
+	}
+	for _, s := range metrics.Hugetlb {
+		expected := uint64(0)
+		if s.Pagesize == "2MB" {
+			expected = 4194304
+		}
+		if s.Limit != expected {
+			t.Errorf("expected %s limit %d but received %d", s.Pagesize, expected, s.Limit)
+		}
+	}
+}
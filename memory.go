@@ -25,6 +25,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 
 	"golang.org/x/sys/unix"
@@ -32,6 +33,30 @@ import (
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
+// MemoryPressureLevel is the memory pressure level reported by
+// memory.pressure_level notifications
+type MemoryPressureLevel string
+
+const (
+	LowPressure      MemoryPressureLevel = "low"
+	MediumPressure   MemoryPressureLevel = "medium"
+	CriticalPressure MemoryPressureLevel = "critical"
+)
+
+// EventNotificationMode controls how memory pressure notifications are
+// propagated between a cgroup and its ancestors
+type EventNotificationMode string
+
+const (
+	// DefaultMode notifies the cgroup and its ancestors until a listener
+	// handles the event
+	DefaultMode EventNotificationMode = ""
+	// LocalMode only notifies when the pressure occurs in the cgroup itself
+	LocalMode EventNotificationMode = "local"
+	// HierarchyMode always notifies the cgroup and all of its ancestors
+	HierarchyMode EventNotificationMode = "hierarchy"
+)
+
 func NewMemory(root string) *memoryController {
 	return &memoryController{
 		root: filepath.Join(root, string(Memory)),
@@ -57,11 +82,15 @@ func (m *memoryController) Create(path string, resources *specs.LinuxResources)
 	if resources.Memory == nil {
 		return nil
 	}
-	if resources.Memory.Kernel != nil {
-		// Check if kernel memory is enabled
-		// We have to limit the kernel memory here as it won't be accounted at all
-		// until a limit is set on the cgroup and limit cannot be set once the
-		// cgroup has children, or if there are already tasks in the cgroup.
+	// Check if kernel memory is enabled
+	// We have to limit the kernel memory here as it won't be accounted at all
+	// until a limit is set on the cgroup and limit cannot be set once the
+	// cgroup has children, or if there are already tasks in the cgroup.
+	// Newer kernels always account kernel memory so there is nothing to enable.
+	if resources.Memory.Kernel != nil && !kmemDeprecated {
+		if !m.hasFile(path, "kmem.limit_in_bytes") {
+			return ErrKernelMemoryNotSupported
+		}
 		for _, i := range []int64{1, -1} {
 			if err := ioutil.WriteFile(
 				filepath.Join(m.Path(path), "memory.kmem.limit_in_bytes"),
@@ -97,6 +126,49 @@ func (m *memoryController) Update(path string, resources *specs.LinuxResources)
 	return m.set(path, settings)
 }
 
+// Diff returns the memory settings of the resources that differ from the
+// cgroup. A limit of -1 matches the largest value the kernel reports.
+func (m *memoryController) Diff(path string, resources *specs.LinuxResources) ([]Change, error) {
+	if resources.Memory == nil {
+		return nil, nil
+	}
+	var changes []Change
+	for _, t := range getMemorySettings(resources) {
+		// the kmem limit is never written where it is deprecated
+		if t.value == nil || (t.name == "kmem.limit_in_bytes" && kmemDeprecated) {
+			continue
+		}
+		file := fmt.Sprintf("memory.%s", t.name)
+		if t.name == "oom_control" {
+			oom, err := m.oomControl(path)
+			if err != nil {
+				return nil, err
+			}
+			if oom.OomKillDisable != uint64(*t.value) {
+				changes = append(changes, Change{
+					Subsystem: Memory,
+					File:      file,
+					Current:   strconv.FormatUint(oom.OomKillDisable, 10),
+					Desired:   strconv.FormatInt(*t.value, 10),
+				})
+			}
+			continue
+		}
+		change, err := diffFile(Memory, m.Path(path), file, strconv.FormatInt(*t.value, 10))
+		if err != nil {
+			return nil, err
+		}
+		if change == nil {
+			continue
+		}
+		if v, err := strconv.ParseUint(change.Current, 10, 64); err == nil && *t.value == -1 && v >= memoryUnlimited {
+			continue
+		}
+		changes = append(changes, *change)
+	}
+	return changes, nil
+}
+
 func (m *memoryController) Stat(path string, stats *Metrics) error {
 	f, err := os.Open(filepath.Join(m.Path(path), "memory.stat"))
 	if err != nil {
@@ -161,17 +233,191 @@ func (m *memoryController) Stat(path string, stats *Metrics) error {
 			parts = append(parts, tt.name)
 			v, err := readUint(filepath.Join(m.Path(path), strings.Join(parts, ".")))
 			if err != nil {
+				// the memsw and kmem files are missing when swap or kernel
+				// memory accounting is disabled, leave those entries empty
+				if t.module != "" && os.IsNotExist(err) {
+					break
+				}
 				return err
 			}
 			*tt.value = v
 		}
 	}
+	oom, err := m.oomControl(path)
+	if err != nil {
+		return err
+	}
+	stats.Memory.OomControl = oom
+	// memory.numa_stat is only available on kernels built with NUMA support
+	numa, err := m.numaStat(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	stats.Memory.NumaStat = numa
 	return nil
 }
 
+// numaStat parses memory.numa_stat into the page counts of each node, the
+// lines have the format "<name>=<total> N0=<pages> N1=<pages>"
+func (m *memoryController) numaStat(path string) ([]*MemoryNumaNode, error) {
+	data, err := ioutil.ReadFile(filepath.Join(m.Path(path), "memory.numa_stat"))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodes []*MemoryNumaNode
+		index = make(map[uint32]*MemoryNumaNode)
+	)
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		name := strings.SplitN(fields[0], "=", 2)[0]
+		for _, field := range fields[1:] {
+			parts := strings.SplitN(field, "=", 2)
+			if len(parts) != 2 || !strings.HasPrefix(parts[0], "N") {
+				return nil, ErrInvalidFormat
+			}
+			id, err := strconv.ParseUint(parts[0][1:], 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			v, err := parseUint(parts[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			node, ok := index[uint32(id)]
+			if !ok {
+				node = &MemoryNumaNode{Node: uint32(id)}
+				index[uint32(id)] = node
+				nodes = append(nodes, node)
+			}
+			switch name {
+			case "total":
+				node.Total = v
+			case "file":
+				node.File = v
+			case "anon":
+				node.Anon = v
+			case "unevictable":
+				node.Unevictable = v
+			case "hierarchical_total":
+				node.HierarchicalTotal = v
+			case "hierarchical_file":
+				node.HierarchicalFile = v
+			case "hierarchical_anon":
+				node.HierarchicalAnon = v
+			case "hierarchical_unevictable":
+				node.HierarchicalUnevictable = v
+			}
+		}
+	}
+	return nodes, nil
+}
+
+// oomControl parses memory.oom_control, oom_kill is only reported by kernels
+// 4.13 and newer
+func (m *memoryController) oomControl(path string) (*MemoryOomControl, error) {
+	data, err := ioutil.ReadFile(filepath.Join(m.Path(path), "memory.oom_control"))
+	if err != nil {
+		return nil, err
+	}
+	var oom MemoryOomControl
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		key, v, err := parseKV(line)
+		if err != nil {
+			return nil, err
+		}
+		switch key {
+		case "oom_kill_disable":
+			oom.OomKillDisable = v
+		case "under_oom":
+			oom.UnderOom = v
+		case "oom_kill":
+			oom.OomKill = v
+		}
+	}
+	return &oom, nil
+}
+
+// SetOOMKillDisable toggles the oom killer for the cgroup. When disabled,
+// tasks hitting the memory limit are paused until memory is freed.
+func (m *memoryController) SetOOMKillDisable(path string, disable bool) error {
+	v := "0"
+	if disable {
+		v = "1"
+	}
+	return ioutil.WriteFile(
+		filepath.Join(m.Path(path), "memory.oom_control"),
+		[]byte(v),
+		defaultFilePerm,
+	)
+}
+
 func (m *memoryController) OOMEventFD(path string) (uintptr, error) {
+	return m.memoryEvent(path, "memory.oom_control", "")
+}
+
+// RegisterOOMEvent returns a channel that is notified each time processes
+// inside the cgroup are affected by an out of memory event
+func (m *memoryController) RegisterOOMEvent(path string) (<-chan struct{}, func(), error) {
+	return m.RegisterEvent(path, "memory.oom_control", "")
+}
+
+// RegisterPressureEvent returns a channel that is notified each time the
+// cgroup reaches the provided memory pressure level. ErrInvalidPressureEvent is
+// returned for an unknown level or mode.
+func (m *memoryController) RegisterPressureEvent(path string, level MemoryPressureLevel, mode EventNotificationMode) (<-chan struct{}, func(), error) {
+	switch level {
+	case LowPressure, MediumPressure, CriticalPressure:
+	default:
+		return nil, nil, ErrInvalidPressureEvent
+	}
+	switch mode {
+	case DefaultMode, LocalMode, HierarchyMode:
+	default:
+		return nil, nil, ErrInvalidPressureEvent
+	}
+	arg := string(level)
+	if mode != DefaultMode {
+		arg = fmt.Sprintf("%s,%s", level, mode)
+	}
+	return m.RegisterEvent(path, "memory.pressure_level", arg)
+}
+
+// RegisterThresholdEvent returns a channel that is notified each time the
+// memory usage of the cgroup crosses the provided threshold in either
+// direction. When swap is true the memory+swap usage is watched instead.
+func (m *memoryController) RegisterThresholdEvent(path string, threshold uint64, swap bool) (<-chan struct{}, func(), error) {
+	file := "memory.usage_in_bytes"
+	if swap {
+		file = "memory.memsw.usage_in_bytes"
+	}
+	return m.RegisterEvent(path, file, strconv.FormatUint(threshold, 10))
+}
+
+// RegisterEvent returns a channel that is notified each time the kernel
+// signals the event registered for the memory file with the provided
+// arguments through cgroup.event_control. The returned func stops the
+// notifications before the cgroup is removed.
+func (m *memoryController) RegisterEvent(path, file, args string) (<-chan struct{}, func(), error) {
+	fd, err := m.memoryEvent(path, file, args)
+	if err != nil {
+		return nil, nil, err
+	}
+	ch, stop := notifyEventFD(m.Path(path), fd)
+	return ch, stop, nil
+}
+
+// memoryEvent creates a new eventfd and registers it with the cgroup's
+// cgroup.event_control for the provided memory file and arguments
+func (m *memoryController) memoryEvent(path, file, arg string) (uintptr, error) {
 	root := m.Path(path)
-	f, err := os.Open(filepath.Join(root, "memory.oom_control"))
+	f, err := os.Open(filepath.Join(root, file))
 	if err != nil {
 		return 0, err
 	}
@@ -180,23 +426,84 @@ func (m *memoryController) OOMEventFD(path string) (uintptr, error) {
 	if serr != 0 {
 		return 0, serr
 	}
-	if err := writeEventFD(root, f.Fd(), fd); err != nil {
+	if err := writeEventFD(root, f.Fd(), fd, arg); err != nil {
 		unix.Close(int(fd))
 		return 0, err
 	}
 	return fd, nil
 }
 
-func writeEventFD(root string, cfd, efd uintptr) error {
+func writeEventFD(root string, cfd, efd uintptr, arg string) error {
 	f, err := os.OpenFile(filepath.Join(root, "cgroup.event_control"), os.O_WRONLY, 0)
 	if err != nil {
 		return err
 	}
-	_, err = f.WriteString(fmt.Sprintf("%d %d", efd, cfd))
+	data := fmt.Sprintf("%d %d", efd, cfd)
+	if arg != "" {
+		data = fmt.Sprintf("%s %s", data, arg)
+	}
+	_, err = f.WriteString(data)
 	f.Close()
 	return err
 }
 
+// notifyEventFD reads from the eventfd until the cgroup at root is removed
+// or the returned func is called, sending on the returned channel each time
+// the event fires. Notifications are coalesced when the receiver is not ready
+// and the channel is closed, along with the eventfd, once reading stops.
+func notifyEventFD(root string, efd uintptr) (<-chan struct{}, func()) {
+	var (
+		ch     = make(chan struct{}, 1)
+		done   = make(chan struct{})
+		mu     sync.Mutex
+		closed bool
+	)
+	stop := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return
+		}
+		closed = true
+		close(done)
+		// wake up the blocked read, the eventfd is only closed by the reader
+		// so it cannot be reused by another file yet
+		unix.Write(int(efd), []byte{1, 0, 0, 0, 0, 0, 0, 0})
+	}
+	go func() {
+		defer func() {
+			mu.Lock()
+			closed = true
+			unix.Close(int(efd))
+			mu.Unlock()
+			close(ch)
+		}()
+		buf := make([]byte, 8)
+		for {
+			if _, err := unix.Read(int(efd), buf); err != nil {
+				if err == unix.EINTR {
+					continue
+				}
+				return
+			}
+			select {
+			case <-done:
+				return
+			default:
+			}
+			// the kernel also signals the eventfd when the cgroup is removed
+			if _, err := os.Lstat(filepath.Join(root, "cgroup.event_control")); os.IsNotExist(err) {
+				return
+			}
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return ch, stop
+}
+
 func (m *memoryController) parseStats(r io.Reader, stat *MemoryStat) error {
 	var (
 		raw  = make(map[string]uint64)
@@ -246,17 +553,38 @@ func (m *memoryController) parseStats(r io.Reader, stat *MemoryStat) error {
 	stat.TotalInactiveFile = raw["total_inactive_file"]
 	stat.TotalActiveFile = raw["total_active_file"]
 	stat.TotalUnevictable = raw["total_unevictable"]
+	stat.Shmem = raw["shmem"]
+	stat.SwapUsage = raw["swap"]
+	stat.TotalShmem = raw["total_shmem"]
+	stat.TotalSwapUsage = raw["total_swap"]
 	return nil
 }
 
 func (m *memoryController) set(path string, settings []memorySettings) error {
 	for _, t := range settings {
 		if t.value != nil {
+			// kernel memory is charged against the memory limit on kernels
+			// where the kmem limit is deprecated, skip it rather than fail
+			if t.name == "kmem.limit_in_bytes" && kmemDeprecated {
+				continue
+			}
+			// kernels built without CONFIG_MEMCG_KMEM, or that dropped kmem
+			// limits entirely, do not provide the kmem interface files
+			if strings.HasPrefix(t.name, "kmem.") && !m.hasFile(path, t.name) {
+				return ErrKernelMemoryNotSupported
+			}
+			// kernels booted with swapaccount=0 do not provide the memsw files
+			if strings.HasPrefix(t.name, "memsw.") && !m.hasFile(path, t.name) {
+				return ErrSwapNotSupported
+			}
 			if err := ioutil.WriteFile(
 				filepath.Join(m.Path(path), fmt.Sprintf("memory.%s", t.name)),
 				[]byte(strconv.FormatInt(*t.value, 10)),
 				defaultFilePerm,
 			); err != nil {
+				if t.name == "kmem.limit_in_bytes" {
+					return checkEBUSY(err)
+				}
 				return err
 			}
 		}
@@ -264,6 +592,42 @@ func (m *memoryController) set(path string, settings []memorySettings) error {
 	return nil
 }
 
+// SwapSupported returns true if swap accounting is available for the memory
+// cgroup directory, memory.memsw.limit_in_bytes on v1 or memory.swap.max on
+// the unified hierarchy. Both are missing on kernels booted with swapaccount=0.
+func SwapSupported(dir string) bool {
+	for _, name := range []string{"memory.memsw.limit_in_bytes", "memory.swap.max"} {
+		if _, err := os.Lstat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ConvertMemorySwapToV2 translates the runtime spec swap value, which is the
+// combined memory and swap limit as used by memory.memsw.limit_in_bytes, into
+// the swap only limit written to memory.swap.max on the unified hierarchy
+func ConvertMemorySwapToV2(memorySwap, memory int64) (int64, error) {
+	// unset and unlimited values are the same for both versions
+	if memorySwap == 0 || memorySwap == -1 {
+		return memorySwap, nil
+	}
+	if memory <= 0 || memorySwap < memory {
+		return 0, ErrInvalidSwapLimit
+	}
+	return memorySwap - memory, nil
+}
+
+// hasFile returns true if the memory.<name> interface file exists for the cgroup
+func (m *memoryController) hasFile(path, name string) bool {
+	_, err := os.Lstat(filepath.Join(m.Path(path), fmt.Sprintf("memory.%s", name)))
+	return err == nil
+}
+
+// memoryUnlimited is the smallest value reported by the kernel for a limit of
+// -1, the exact value is rounded down to the page size
+const memoryUnlimited = 1 << 62
+
 type memorySettings struct {
 	name  string
 	value *int64
@@ -285,6 +649,10 @@ func getMemorySettings(resources *specs.LinuxResources) []memorySettings {
 			name:  "memsw.limit_in_bytes",
 			value: mem.Swap,
 		},
+		{
+			name:  "soft_limit_in_bytes",
+			value: mem.Reservation,
+		},
 		{
 			name:  "kmem.limit_in_bytes",
 			value: mem.Kernel,
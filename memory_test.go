@@ -17,8 +17,15 @@
 package cgroups
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 const memoryData = `cache 1
@@ -53,6 +60,10 @@ total_active_anon 29
 total_inactive_file 30
 total_active_file 31
 total_unevictable 32
+shmem 33
+swap 34
+total_shmem 35
+total_swap 36
 `
 
 func TestParseMemoryStats(t *testing.T) {
@@ -97,6 +108,10 @@ func TestParseMemoryStats(t *testing.T) {
 		m.TotalInactiveFile,
 		m.TotalActiveFile,
 		m.TotalUnevictable,
+		m.Shmem,
+		m.SwapUsage,
+		m.TotalShmem,
+		m.TotalSwapUsage,
 	}
 	for i, v := range index {
 		if v != uint64(i)+1 {
@@ -104,3 +119,478 @@ func TestParseMemoryStats(t *testing.T) {
 		}
 	}
 }
+
+func TestMemorySoftLimit(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	memory := NewMemory(mock.root)
+	reservation := int64(512)
+	if err := memory.Create("test", &specs.LinuxResources{
+		Memory: &specs.LinuxMemory{
+			Reservation: &reservation,
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	v, err := readUint(filepath.Join(mock.root, "memory", "test", "memory.soft_limit_in_bytes"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != uint64(reservation) {
+		t.Fatalf("expected soft limit %d but received %d", reservation, v)
+	}
+}
+
+func TestMemoryKernelNotSupported(t *testing.T) {
+	defer func(v bool) {
+		kmemDeprecated = v
+	}(kmemDeprecated)
+	kmemDeprecated = false
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	memory := NewMemory(mock.root)
+	kernel := int64(1024)
+	err = memory.Create("test", &specs.LinuxResources{
+		Memory: &specs.LinuxMemory{
+			Kernel: &kernel,
+		},
+	})
+	if err != ErrKernelMemoryNotSupported {
+		t.Fatalf("expected error %q but received %v", ErrKernelMemoryNotSupported, err)
+	}
+}
+
+func TestMemoryKernelDeprecatedMissing(t *testing.T) {
+	defer func(v bool) {
+		kmemDeprecated = v
+	}(kmemDeprecated)
+	kmemDeprecated = true
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	memory := NewMemory(mock.root)
+	kernel := int64(1024)
+	resources := &specs.LinuxResources{
+		Memory: &specs.LinuxMemory{
+			Kernel: &kernel,
+		},
+	}
+	if err := memory.Create("test", resources); err != nil {
+		t.Fatal(err)
+	}
+	changes, err := memory.Diff("test", resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no changes but received %v", changes)
+	}
+}
+
+func TestMemoryKernelDeprecated(t *testing.T) {
+	defer func(v bool) {
+		kmemDeprecated = v
+	}(kmemDeprecated)
+	for _, tt := range []struct {
+		deprecated bool
+		expected   string
+	}{
+		{
+			deprecated: false,
+			expected:   "1024",
+		},
+		{
+			deprecated: true,
+			expected:   "",
+		},
+	} {
+		kmemDeprecated = tt.deprecated
+		mock, err := newMock()
+		if err != nil {
+			t.Fatal(err)
+		}
+		memory := NewMemory(mock.root)
+		if err := os.MkdirAll(memory.Path("test"), defaultDirPerm); err != nil {
+			t.Fatal(err)
+		}
+		file := filepath.Join(memory.Path("test"), "memory.kmem.limit_in_bytes")
+		if err := ioutil.WriteFile(file, nil, defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+		kernel := int64(1024)
+		if err := memory.Create("test", &specs.LinuxResources{
+			Memory: &specs.LinuxMemory{
+				Kernel: &kernel,
+			},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadFile(file)
+		mock.delete()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != tt.expected {
+			t.Errorf("expected kmem limit %q with deprecated %v but received %q", tt.expected, tt.deprecated, data)
+		}
+	}
+}
+
+func TestMemoryStatWithoutSwapAndKernel(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	memory := NewMemory(mock.root)
+	if err := os.MkdirAll(memory.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	for name, value := range map[string]string{
+		"memory.stat":               memoryData,
+		"memory.usage_in_bytes":     "100",
+		"memory.max_usage_in_bytes": "200",
+		"memory.failcnt":            "0",
+		"memory.limit_in_bytes":     "300",
+		"memory.oom_control":        "oom_kill_disable 0\nunder_oom 0\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(memory.Path("test"), name), []byte(value), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var metrics Metrics
+	if err := memory.Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	if metrics.Memory.Usage.Limit != 300 {
+		t.Errorf("expected limit 300 but received %d", metrics.Memory.Usage.Limit)
+	}
+	if metrics.Memory.Kernel.Usage != 0 || metrics.Memory.Swap.Usage != 0 {
+		t.Errorf("expected empty kernel and swap entries but received %+v and %+v", metrics.Memory.Kernel, metrics.Memory.Swap)
+	}
+}
+
+func TestMemorySwapNotSupported(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	memory := NewMemory(mock.root)
+	if err := os.MkdirAll(memory.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if SwapSupported(memory.Path("test")) {
+		t.Fatal("expected swap to not be supported without memsw files")
+	}
+	swap := int64(2048)
+	err = memory.Create("test", &specs.LinuxResources{
+		Memory: &specs.LinuxMemory{
+			Swap: &swap,
+		},
+	})
+	if err != ErrSwapNotSupported {
+		t.Fatalf("expected error %q but received %v", ErrSwapNotSupported, err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(memory.Path("test"), "memory.memsw.limit_in_bytes"), nil, defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	if !SwapSupported(memory.Path("test")) {
+		t.Fatal("expected swap to be supported with memsw files")
+	}
+}
+
+func TestConvertMemorySwapToV2(t *testing.T) {
+	for _, tt := range []struct {
+		swap, memory int64
+		expected     int64
+		err          error
+	}{
+		{swap: 0, memory: 1024, expected: 0},
+		{swap: -1, memory: 1024, expected: -1},
+		{swap: 3072, memory: 1024, expected: 2048},
+		{swap: 1024, memory: 1024, expected: 0},
+		{swap: 512, memory: 1024, err: ErrInvalidSwapLimit},
+		{swap: 1024, memory: -1, err: ErrInvalidSwapLimit},
+	} {
+		v, err := ConvertMemorySwapToV2(tt.swap, tt.memory)
+		if err != tt.err {
+			t.Errorf("expected error %v for swap %d and memory %d but received %v", tt.err, tt.swap, tt.memory, err)
+			continue
+		}
+		if v != tt.expected {
+			t.Errorf("expected swap %d for swap %d and memory %d but received %d", tt.expected, tt.swap, tt.memory, v)
+		}
+	}
+}
+
+func TestMemoryRegisterEvent(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := filepath.Join(mock.root, string(Memory), "test")
+	for _, f := range []string{"cgroup.event_control", "memory.custom_event"} {
+		if err := ioutil.WriteFile(filepath.Join(root, f), nil, defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := control.RegisterMemoryEvent("memory.missing_event", ""); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error for a missing event file but received %v", err)
+	}
+	ch, err := control.RegisterMemoryEvent("memory.custom_event", "high")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(root, "cgroup.event_control"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var efd, cfd int
+	var args string
+	if n, err := fmt.Sscanf(string(data), "%d %d %s", &efd, &cfd, &args); err != nil || n != 3 {
+		t.Fatalf("unexpected cgroup.event_control content %q", data)
+	}
+	if args != "high" {
+		t.Fatalf("expected event arguments %q but received %q", "high", args)
+	}
+	if err := control.Delete(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Fatal("expected the event channel to be closed without a notification")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the event channel to be closed once the cgroup is deleted")
+	}
+}
+
+// eventControlArgs returns the arguments that follow the eventfd and the
+// watched file descriptor in cgroup.event_control
+func eventControlArgs(t *testing.T, root string) string {
+	data, err := ioutil.ReadFile(filepath.Join(root, "cgroup.event_control"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) != 3 {
+		t.Fatalf("unexpected cgroup.event_control content %q", data)
+	}
+	return fields[2]
+}
+
+func TestMemoryRegisterPressureEvent(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	memory := NewMemory(mock.root)
+	root := memory.Path("test")
+	if err := os.MkdirAll(root, defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "memory.pressure_level"), nil, defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		level    MemoryPressureLevel
+		mode     EventNotificationMode
+		expected string
+	}{
+		{level: LowPressure, expected: "low"},
+		{level: MediumPressure, mode: LocalMode, expected: "medium,local"},
+		{level: CriticalPressure, mode: HierarchyMode, expected: "critical,hierarchy"},
+	} {
+		// the registration is written without truncating the file
+		if err := ioutil.WriteFile(filepath.Join(root, "cgroup.event_control"), nil, defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+		_, stop, err := memory.RegisterPressureEvent("test", tt.level, tt.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stop()
+		if args := eventControlArgs(t, root); args != tt.expected {
+			t.Errorf("expected event arguments %q but received %q", tt.expected, args)
+		}
+	}
+	if _, _, err := memory.RegisterPressureEvent("test", "high", DefaultMode); err != ErrInvalidPressureEvent {
+		t.Errorf("expected ErrInvalidPressureEvent for an unknown level but received %v", err)
+	}
+	if _, _, err := memory.RegisterPressureEvent("test", LowPressure, "global"); err != ErrInvalidPressureEvent {
+		t.Errorf("expected ErrInvalidPressureEvent for an unknown mode but received %v", err)
+	}
+}
+
+func TestMemoryRegisterThreshold(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := filepath.Join(mock.root, string(Memory), "test")
+	for _, f := range []string{"cgroup.event_control", "memory.usage_in_bytes"} {
+		if err := ioutil.WriteFile(filepath.Join(root, f), nil, defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := control.RegisterMemoryThreshold(1048576, false); err != nil {
+		t.Fatal(err)
+	}
+	if args := eventControlArgs(t, root); args != "1048576" {
+		t.Errorf("expected threshold %q but received %q", "1048576", args)
+	}
+	// the memory+swap usage is watched with swap
+	if _, err := control.RegisterMemoryThreshold(2097152, true); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error without memory.memsw.usage_in_bytes but received %v", err)
+	}
+	for _, f := range []string{"cgroup.event_control", "memory.memsw.usage_in_bytes"} {
+		if err := ioutil.WriteFile(filepath.Join(root, f), nil, defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Remove(filepath.Join(root, "memory.usage_in_bytes")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := control.RegisterMemoryThreshold(2097152, true); err != nil {
+		t.Fatal(err)
+	}
+	if args := eventControlArgs(t, root); args != "2097152" {
+		t.Errorf("expected threshold %q but received %q", "2097152", args)
+	}
+	if err := control.Delete(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryRegisterOOMEvent(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := filepath.Join(mock.root, string(Memory), "test")
+	if err := os.Remove(filepath.Join(root, "memory.oom_control")); err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "cgroup.event_control"), nil, defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := control.RegisterMemoryOOMEvent(); !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error without memory.oom_control but received %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(root, "memory.oom_control"), nil, defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := control.RegisterMemoryOOMEvent(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(root, "cgroup.event_control"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the oom event has no arguments
+	if fields := strings.Fields(string(data)); len(fields) != 2 {
+		t.Errorf("expected only the eventfd and memory.oom_control in cgroup.event_control but received %q", data)
+	}
+	if err := control.Delete(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryOOMControl(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	memory := NewMemory(mock.root)
+	if err := os.MkdirAll(memory.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	oomControl := filepath.Join(memory.Path("test"), "memory.oom_control")
+	if err := memory.SetOOMKillDisable("test", true); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(oomControl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "1" {
+		t.Fatalf("expected oom killer to be disabled but received %q", data)
+	}
+	if err := ioutil.WriteFile(oomControl, []byte("oom_kill_disable 1\nunder_oom 1\noom_kill 3\n"), defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	oom, err := memory.oomControl("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if oom.OomKillDisable != 1 || oom.UnderOom != 1 || oom.OomKill != 3 {
+		t.Fatalf("unexpected oom control %+v", oom)
+	}
+}
+
+func TestMemoryNumaStat(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	memory := NewMemory(mock.root)
+	if err := os.MkdirAll(memory.Path("test"), defaultDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	const data = `total=300 N0=200 N1=100
+file=100 N0=60 N1=40
+anon=190 N0=135 N1=55
+unevictable=10 N0=5 N1=5
+hierarchical_total=600 N0=400 N1=200
+hierarchical_file=200 N0=120 N1=80
+hierarchical_anon=380 N0=270 N1=110
+hierarchical_unevictable=20 N0=10 N1=10
+`
+	if err := ioutil.WriteFile(filepath.Join(memory.Path("test"), "memory.numa_stat"), []byte(data), defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	nodes, err := memory.numaStat("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []MemoryNumaNode{
+		{Node: 0, Total: 200, File: 60, Anon: 135, Unevictable: 5, HierarchicalTotal: 400, HierarchicalFile: 120, HierarchicalAnon: 270, HierarchicalUnevictable: 10},
+		{Node: 1, Total: 100, File: 40, Anon: 55, Unevictable: 5, HierarchicalTotal: 200, HierarchicalFile: 80, HierarchicalAnon: 110, HierarchicalUnevictable: 10},
+	}
+	if len(nodes) != len(expected) {
+		t.Fatalf("expected %d numa nodes but received %d", len(expected), len(nodes))
+	}
+	for i, n := range nodes {
+		if *n != expected[i] {
+			t.Errorf("expected %+v but received %+v", expected[i], *n)
+		}
+	}
+}
@@ -15,12 +15,15 @@
 		CPUUsage
 		Throttle
 		MemoryStat
+		MemoryOomControl
+		MemoryNumaNode
 		MemoryEntry
 		BlkIOStat
 		BlkIOEntry
 		RdmaStat
 		RdmaEntry
 		NetworkStat
+		MiscStat
 */
 package cgroups
 
@@ -53,6 +56,7 @@ type Metrics struct {
 	Blkio   *BlkIOStat     `protobuf:"bytes,5,opt,name=blkio" json:"blkio,omitempty"`
 	Rdma    *RdmaStat      `protobuf:"bytes,6,opt,name=rdma" json:"rdma,omitempty"`
 	Network []*NetworkStat `protobuf:"bytes,7,rep,name=network" json:"network,omitempty"`
+	Misc    []*MiscStat    `protobuf:"bytes,8,rep,name=misc" json:"misc,omitempty"`
 }
 
 func (m *Metrics) Reset()                    { *m = Metrics{} }
@@ -64,6 +68,7 @@ type HugetlbStat struct {
 	Max      uint64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
 	Failcnt  uint64 `protobuf:"varint,3,opt,name=failcnt,proto3" json:"failcnt,omitempty"`
 	Pagesize string `protobuf:"bytes,4,opt,name=pagesize,proto3" json:"pagesize,omitempty"`
+	Limit    uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
 func (m *HugetlbStat) Reset()                    { *m = HugetlbStat{} }
@@ -73,6 +78,8 @@ func (*HugetlbStat) Descriptor() ([]byte, []int) { return fileDescriptorMetrics,
 type PidsStat struct {
 	Current uint64 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
 	Limit   uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// number of times a fork failed because the limit was reached
+	MaxEvents uint64 `protobuf:"varint,3,opt,name=max_events,json=maxEvents,proto3" json:"max_events,omitempty"`
 }
 
 func (m *PidsStat) Reset()                    { *m = PidsStat{} }
@@ -111,48 +118,80 @@ func (*Throttle) ProtoMessage()               {}
 func (*Throttle) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{5} }
 
 type MemoryStat struct {
-	Cache                   uint64       `protobuf:"varint,1,opt,name=cache,proto3" json:"cache,omitempty"`
-	RSS                     uint64       `protobuf:"varint,2,opt,name=rss,proto3" json:"rss,omitempty"`
-	RSSHuge                 uint64       `protobuf:"varint,3,opt,name=rss_huge,json=rssHuge,proto3" json:"rss_huge,omitempty"`
-	MappedFile              uint64       `protobuf:"varint,4,opt,name=mapped_file,json=mappedFile,proto3" json:"mapped_file,omitempty"`
-	Dirty                   uint64       `protobuf:"varint,5,opt,name=dirty,proto3" json:"dirty,omitempty"`
-	Writeback               uint64       `protobuf:"varint,6,opt,name=writeback,proto3" json:"writeback,omitempty"`
-	PgPgIn                  uint64       `protobuf:"varint,7,opt,name=pg_pg_in,json=pgPgIn,proto3" json:"pg_pg_in,omitempty"`
-	PgPgOut                 uint64       `protobuf:"varint,8,opt,name=pg_pg_out,json=pgPgOut,proto3" json:"pg_pg_out,omitempty"`
-	PgFault                 uint64       `protobuf:"varint,9,opt,name=pg_fault,json=pgFault,proto3" json:"pg_fault,omitempty"`
-	PgMajFault              uint64       `protobuf:"varint,10,opt,name=pg_maj_fault,json=pgMajFault,proto3" json:"pg_maj_fault,omitempty"`
-	InactiveAnon            uint64       `protobuf:"varint,11,opt,name=inactive_anon,json=inactiveAnon,proto3" json:"inactive_anon,omitempty"`
-	ActiveAnon              uint64       `protobuf:"varint,12,opt,name=active_anon,json=activeAnon,proto3" json:"active_anon,omitempty"`
-	InactiveFile            uint64       `protobuf:"varint,13,opt,name=inactive_file,json=inactiveFile,proto3" json:"inactive_file,omitempty"`
-	ActiveFile              uint64       `protobuf:"varint,14,opt,name=active_file,json=activeFile,proto3" json:"active_file,omitempty"`
-	Unevictable             uint64       `protobuf:"varint,15,opt,name=unevictable,proto3" json:"unevictable,omitempty"`
-	HierarchicalMemoryLimit uint64       `protobuf:"varint,16,opt,name=hierarchical_memory_limit,json=hierarchicalMemoryLimit,proto3" json:"hierarchical_memory_limit,omitempty"`
-	HierarchicalSwapLimit   uint64       `protobuf:"varint,17,opt,name=hierarchical_swap_limit,json=hierarchicalSwapLimit,proto3" json:"hierarchical_swap_limit,omitempty"`
-	TotalCache              uint64       `protobuf:"varint,18,opt,name=total_cache,json=totalCache,proto3" json:"total_cache,omitempty"`
-	TotalRSS                uint64       `protobuf:"varint,19,opt,name=total_rss,json=totalRss,proto3" json:"total_rss,omitempty"`
-	TotalRSSHuge            uint64       `protobuf:"varint,20,opt,name=total_rss_huge,json=totalRssHuge,proto3" json:"total_rss_huge,omitempty"`
-	TotalMappedFile         uint64       `protobuf:"varint,21,opt,name=total_mapped_file,json=totalMappedFile,proto3" json:"total_mapped_file,omitempty"`
-	TotalDirty              uint64       `protobuf:"varint,22,opt,name=total_dirty,json=totalDirty,proto3" json:"total_dirty,omitempty"`
-	TotalWriteback          uint64       `protobuf:"varint,23,opt,name=total_writeback,json=totalWriteback,proto3" json:"total_writeback,omitempty"`
-	TotalPgPgIn             uint64       `protobuf:"varint,24,opt,name=total_pg_pg_in,json=totalPgPgIn,proto3" json:"total_pg_pg_in,omitempty"`
-	TotalPgPgOut            uint64       `protobuf:"varint,25,opt,name=total_pg_pg_out,json=totalPgPgOut,proto3" json:"total_pg_pg_out,omitempty"`
-	TotalPgFault            uint64       `protobuf:"varint,26,opt,name=total_pg_fault,json=totalPgFault,proto3" json:"total_pg_fault,omitempty"`
-	TotalPgMajFault         uint64       `protobuf:"varint,27,opt,name=total_pg_maj_fault,json=totalPgMajFault,proto3" json:"total_pg_maj_fault,omitempty"`
-	TotalInactiveAnon       uint64       `protobuf:"varint,28,opt,name=total_inactive_anon,json=totalInactiveAnon,proto3" json:"total_inactive_anon,omitempty"`
-	TotalActiveAnon         uint64       `protobuf:"varint,29,opt,name=total_active_anon,json=totalActiveAnon,proto3" json:"total_active_anon,omitempty"`
-	TotalInactiveFile       uint64       `protobuf:"varint,30,opt,name=total_inactive_file,json=totalInactiveFile,proto3" json:"total_inactive_file,omitempty"`
-	TotalActiveFile         uint64       `protobuf:"varint,31,opt,name=total_active_file,json=totalActiveFile,proto3" json:"total_active_file,omitempty"`
-	TotalUnevictable        uint64       `protobuf:"varint,32,opt,name=total_unevictable,json=totalUnevictable,proto3" json:"total_unevictable,omitempty"`
-	Usage                   *MemoryEntry `protobuf:"bytes,33,opt,name=usage" json:"usage,omitempty"`
-	Swap                    *MemoryEntry `protobuf:"bytes,34,opt,name=swap" json:"swap,omitempty"`
-	Kernel                  *MemoryEntry `protobuf:"bytes,35,opt,name=kernel" json:"kernel,omitempty"`
-	KernelTCP               *MemoryEntry `protobuf:"bytes,36,opt,name=kernel_tcp,json=kernelTcp" json:"kernel_tcp,omitempty"`
+	Cache                   uint64            `protobuf:"varint,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	RSS                     uint64            `protobuf:"varint,2,opt,name=rss,proto3" json:"rss,omitempty"`
+	RSSHuge                 uint64            `protobuf:"varint,3,opt,name=rss_huge,json=rssHuge,proto3" json:"rss_huge,omitempty"`
+	MappedFile              uint64            `protobuf:"varint,4,opt,name=mapped_file,json=mappedFile,proto3" json:"mapped_file,omitempty"`
+	Dirty                   uint64            `protobuf:"varint,5,opt,name=dirty,proto3" json:"dirty,omitempty"`
+	Writeback               uint64            `protobuf:"varint,6,opt,name=writeback,proto3" json:"writeback,omitempty"`
+	PgPgIn                  uint64            `protobuf:"varint,7,opt,name=pg_pg_in,json=pgPgIn,proto3" json:"pg_pg_in,omitempty"`
+	PgPgOut                 uint64            `protobuf:"varint,8,opt,name=pg_pg_out,json=pgPgOut,proto3" json:"pg_pg_out,omitempty"`
+	PgFault                 uint64            `protobuf:"varint,9,opt,name=pg_fault,json=pgFault,proto3" json:"pg_fault,omitempty"`
+	PgMajFault              uint64            `protobuf:"varint,10,opt,name=pg_maj_fault,json=pgMajFault,proto3" json:"pg_maj_fault,omitempty"`
+	InactiveAnon            uint64            `protobuf:"varint,11,opt,name=inactive_anon,json=inactiveAnon,proto3" json:"inactive_anon,omitempty"`
+	ActiveAnon              uint64            `protobuf:"varint,12,opt,name=active_anon,json=activeAnon,proto3" json:"active_anon,omitempty"`
+	InactiveFile            uint64            `protobuf:"varint,13,opt,name=inactive_file,json=inactiveFile,proto3" json:"inactive_file,omitempty"`
+	ActiveFile              uint64            `protobuf:"varint,14,opt,name=active_file,json=activeFile,proto3" json:"active_file,omitempty"`
+	Unevictable             uint64            `protobuf:"varint,15,opt,name=unevictable,proto3" json:"unevictable,omitempty"`
+	HierarchicalMemoryLimit uint64            `protobuf:"varint,16,opt,name=hierarchical_memory_limit,json=hierarchicalMemoryLimit,proto3" json:"hierarchical_memory_limit,omitempty"`
+	HierarchicalSwapLimit   uint64            `protobuf:"varint,17,opt,name=hierarchical_swap_limit,json=hierarchicalSwapLimit,proto3" json:"hierarchical_swap_limit,omitempty"`
+	TotalCache              uint64            `protobuf:"varint,18,opt,name=total_cache,json=totalCache,proto3" json:"total_cache,omitempty"`
+	TotalRSS                uint64            `protobuf:"varint,19,opt,name=total_rss,json=totalRss,proto3" json:"total_rss,omitempty"`
+	TotalRSSHuge            uint64            `protobuf:"varint,20,opt,name=total_rss_huge,json=totalRssHuge,proto3" json:"total_rss_huge,omitempty"`
+	TotalMappedFile         uint64            `protobuf:"varint,21,opt,name=total_mapped_file,json=totalMappedFile,proto3" json:"total_mapped_file,omitempty"`
+	TotalDirty              uint64            `protobuf:"varint,22,opt,name=total_dirty,json=totalDirty,proto3" json:"total_dirty,omitempty"`
+	TotalWriteback          uint64            `protobuf:"varint,23,opt,name=total_writeback,json=totalWriteback,proto3" json:"total_writeback,omitempty"`
+	TotalPgPgIn             uint64            `protobuf:"varint,24,opt,name=total_pg_pg_in,json=totalPgPgIn,proto3" json:"total_pg_pg_in,omitempty"`
+	TotalPgPgOut            uint64            `protobuf:"varint,25,opt,name=total_pg_pg_out,json=totalPgPgOut,proto3" json:"total_pg_pg_out,omitempty"`
+	TotalPgFault            uint64            `protobuf:"varint,26,opt,name=total_pg_fault,json=totalPgFault,proto3" json:"total_pg_fault,omitempty"`
+	TotalPgMajFault         uint64            `protobuf:"varint,27,opt,name=total_pg_maj_fault,json=totalPgMajFault,proto3" json:"total_pg_maj_fault,omitempty"`
+	TotalInactiveAnon       uint64            `protobuf:"varint,28,opt,name=total_inactive_anon,json=totalInactiveAnon,proto3" json:"total_inactive_anon,omitempty"`
+	TotalActiveAnon         uint64            `protobuf:"varint,29,opt,name=total_active_anon,json=totalActiveAnon,proto3" json:"total_active_anon,omitempty"`
+	TotalInactiveFile       uint64            `protobuf:"varint,30,opt,name=total_inactive_file,json=totalInactiveFile,proto3" json:"total_inactive_file,omitempty"`
+	TotalActiveFile         uint64            `protobuf:"varint,31,opt,name=total_active_file,json=totalActiveFile,proto3" json:"total_active_file,omitempty"`
+	TotalUnevictable        uint64            `protobuf:"varint,32,opt,name=total_unevictable,json=totalUnevictable,proto3" json:"total_unevictable,omitempty"`
+	Usage                   *MemoryEntry      `protobuf:"bytes,33,opt,name=usage" json:"usage,omitempty"`
+	Swap                    *MemoryEntry      `protobuf:"bytes,34,opt,name=swap" json:"swap,omitempty"`
+	Kernel                  *MemoryEntry      `protobuf:"bytes,35,opt,name=kernel" json:"kernel,omitempty"`
+	KernelTCP               *MemoryEntry      `protobuf:"bytes,36,opt,name=kernel_tcp,json=kernelTcp" json:"kernel_tcp,omitempty"`
+	OomControl              *MemoryOomControl `protobuf:"bytes,37,opt,name=oom_control,json=oomControl" json:"oom_control,omitempty"`
+	NumaStat                []*MemoryNumaNode `protobuf:"bytes,38,rep,name=numa_stat,json=numaStat" json:"numa_stat,omitempty"`
+	Shmem                   uint64            `protobuf:"varint,39,opt,name=shmem,proto3" json:"shmem,omitempty"`
+	SwapUsage               uint64            `protobuf:"varint,40,opt,name=swap_usage,json=swapUsage,proto3" json:"swap_usage,omitempty"`
+	TotalShmem              uint64            `protobuf:"varint,41,opt,name=total_shmem,json=totalShmem,proto3" json:"total_shmem,omitempty"`
+	TotalSwapUsage          uint64            `protobuf:"varint,42,opt,name=total_swap_usage,json=totalSwapUsage,proto3" json:"total_swap_usage,omitempty"`
 }
 
 func (m *MemoryStat) Reset()                    { *m = MemoryStat{} }
 func (*MemoryStat) ProtoMessage()               {}
 func (*MemoryStat) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{6} }
 
+type MemoryOomControl struct {
+	OomKillDisable uint64 `protobuf:"varint,1,opt,name=oom_kill_disable,json=oomKillDisable,proto3" json:"oom_kill_disable,omitempty"`
+	UnderOom       uint64 `protobuf:"varint,2,opt,name=under_oom,json=underOom,proto3" json:"under_oom,omitempty"`
+	OomKill        uint64 `protobuf:"varint,3,opt,name=oom_kill,json=oomKill,proto3" json:"oom_kill,omitempty"`
+}
+
+func (m *MemoryOomControl) Reset()                    { *m = MemoryOomControl{} }
+func (*MemoryOomControl) ProtoMessage()               {}
+func (*MemoryOomControl) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{7} }
+
+type MemoryNumaNode struct {
+	Node                    uint32 `protobuf:"varint,1,opt,name=node,proto3" json:"node,omitempty"`
+	Total                   uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	File                    uint64 `protobuf:"varint,3,opt,name=file,proto3" json:"file,omitempty"`
+	Anon                    uint64 `protobuf:"varint,4,opt,name=anon,proto3" json:"anon,omitempty"`
+	Unevictable             uint64 `protobuf:"varint,5,opt,name=unevictable,proto3" json:"unevictable,omitempty"`
+	HierarchicalTotal       uint64 `protobuf:"varint,6,opt,name=hierarchical_total,json=hierarchicalTotal,proto3" json:"hierarchical_total,omitempty"`
+	HierarchicalFile        uint64 `protobuf:"varint,7,opt,name=hierarchical_file,json=hierarchicalFile,proto3" json:"hierarchical_file,omitempty"`
+	HierarchicalAnon        uint64 `protobuf:"varint,8,opt,name=hierarchical_anon,json=hierarchicalAnon,proto3" json:"hierarchical_anon,omitempty"`
+	HierarchicalUnevictable uint64 `protobuf:"varint,9,opt,name=hierarchical_unevictable,json=hierarchicalUnevictable,proto3" json:"hierarchical_unevictable,omitempty"`
+}
+
+func (m *MemoryNumaNode) Reset()                    { *m = MemoryNumaNode{} }
+func (*MemoryNumaNode) ProtoMessage()               {}
+func (*MemoryNumaNode) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{8} }
+
 type MemoryEntry struct {
 	Limit   uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	Usage   uint64 `protobuf:"varint,2,opt,name=usage,proto3" json:"usage,omitempty"`
@@ -162,7 +201,7 @@ type MemoryEntry struct {
 
 func (m *MemoryEntry) Reset()                    { *m = MemoryEntry{} }
 func (*MemoryEntry) ProtoMessage()               {}
-func (*MemoryEntry) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{7} }
+func (*MemoryEntry) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{9} }
 
 type BlkIOStat struct {
 	IoServiceBytesRecursive []*BlkIOEntry `protobuf:"bytes,1,rep,name=io_service_bytes_recursive,json=ioServiceBytesRecursive" json:"io_service_bytes_recursive,omitempty"`
@@ -173,11 +212,12 @@ type BlkIOStat struct {
 	IoMergedRecursive       []*BlkIOEntry `protobuf:"bytes,6,rep,name=io_merged_recursive,json=ioMergedRecursive" json:"io_merged_recursive,omitempty"`
 	IoTimeRecursive         []*BlkIOEntry `protobuf:"bytes,7,rep,name=io_time_recursive,json=ioTimeRecursive" json:"io_time_recursive,omitempty"`
 	SectorsRecursive        []*BlkIOEntry `protobuf:"bytes,8,rep,name=sectors_recursive,json=sectorsRecursive" json:"sectors_recursive,omitempty"`
+	Hierarchical            bool          `protobuf:"varint,9,opt,name=hierarchical,proto3" json:"hierarchical,omitempty"`
 }
 
 func (m *BlkIOStat) Reset()                    { *m = BlkIOStat{} }
 func (*BlkIOStat) ProtoMessage()               {}
-func (*BlkIOStat) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{8} }
+func (*BlkIOStat) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{10} }
 
 type BlkIOEntry struct {
 	Op     string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
@@ -189,7 +229,7 @@ type BlkIOEntry struct {
 
 func (m *BlkIOEntry) Reset()                    { *m = BlkIOEntry{} }
 func (*BlkIOEntry) ProtoMessage()               {}
-func (*BlkIOEntry) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{9} }
+func (*BlkIOEntry) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{11} }
 
 type RdmaStat struct {
 	Current []*RdmaEntry `protobuf:"bytes,1,rep,name=current" json:"current,omitempty"`
@@ -198,7 +238,7 @@ type RdmaStat struct {
 
 func (m *RdmaStat) Reset()                    { *m = RdmaStat{} }
 func (*RdmaStat) ProtoMessage()               {}
-func (*RdmaStat) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{10} }
+func (*RdmaStat) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{12} }
 
 type RdmaEntry struct {
 	Device     string `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
@@ -208,7 +248,7 @@ type RdmaEntry struct {
 
 func (m *RdmaEntry) Reset()                    { *m = RdmaEntry{} }
 func (*RdmaEntry) ProtoMessage()               {}
-func (*RdmaEntry) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{11} }
+func (*RdmaEntry) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{13} }
 
 type NetworkStat struct {
 	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -224,7 +264,18 @@ type NetworkStat struct {
 
 func (m *NetworkStat) Reset()                    { *m = NetworkStat{} }
 func (*NetworkStat) ProtoMessage()               {}
-func (*NetworkStat) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{12} }
+func (*NetworkStat) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{14} }
+
+type MiscStat struct {
+	Resource  string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Current   uint64 `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	Limit     uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	MaxEvents uint64 `protobuf:"varint,4,opt,name=max_events,json=maxEvents,proto3" json:"max_events,omitempty"`
+}
+
+func (m *MiscStat) Reset()                    { *m = MiscStat{} }
+func (*MiscStat) ProtoMessage()               {}
+func (*MiscStat) Descriptor() ([]byte, []int) { return fileDescriptorMetrics, []int{15} }
 
 func init() {
 	proto.RegisterType((*Metrics)(nil), "io.containerd.cgroups.v1.Metrics")
@@ -234,12 +285,15 @@ func init() {
 	proto.RegisterType((*CPUUsage)(nil), "io.containerd.cgroups.v1.CPUUsage")
 	proto.RegisterType((*Throttle)(nil), "io.containerd.cgroups.v1.Throttle")
 	proto.RegisterType((*MemoryStat)(nil), "io.containerd.cgroups.v1.MemoryStat")
+	proto.RegisterType((*MemoryOomControl)(nil), "io.containerd.cgroups.v1.MemoryOomControl")
+	proto.RegisterType((*MemoryNumaNode)(nil), "io.containerd.cgroups.v1.MemoryNumaNode")
 	proto.RegisterType((*MemoryEntry)(nil), "io.containerd.cgroups.v1.MemoryEntry")
 	proto.RegisterType((*BlkIOStat)(nil), "io.containerd.cgroups.v1.BlkIOStat")
 	proto.RegisterType((*BlkIOEntry)(nil), "io.containerd.cgroups.v1.BlkIOEntry")
 	proto.RegisterType((*RdmaStat)(nil), "io.containerd.cgroups.v1.RdmaStat")
 	proto.RegisterType((*RdmaEntry)(nil), "io.containerd.cgroups.v1.RdmaEntry")
 	proto.RegisterType((*NetworkStat)(nil), "io.containerd.cgroups.v1.NetworkStat")
+	proto.RegisterType((*MiscStat)(nil), "io.containerd.cgroups.v1.MiscStat")
 }
 func (m *Metrics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -330,6 +384,18 @@ func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Misc) > 0 {
+		for _, msg := range m.Misc {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintMetrics(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -369,6 +435,11 @@ func (m *HugetlbStat) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMetrics(dAtA, i, uint64(len(m.Pagesize)))
 		i += copy(dAtA[i:], m.Pagesize)
 	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.Limit))
+	}
 	return i, nil
 }
 
@@ -397,6 +468,11 @@ func (m *PidsStat) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMetrics(dAtA, i, uint64(m.Limit))
 	}
+	if m.MaxEvents != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.MaxEvents))
+	}
 	return i, nil
 }
 
@@ -778,6 +854,156 @@ func (m *MemoryStat) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n13
 	}
+	if m.OomControl != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.OomControl.Size()))
+		n14, err := m.OomControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.NumaStat) > 0 {
+		for _, msg := range m.NumaStat {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintMetrics(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Shmem != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.Shmem))
+	}
+	if m.SwapUsage != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.SwapUsage))
+	}
+	if m.TotalShmem != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.TotalShmem))
+	}
+	if m.TotalSwapUsage != 0 {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.TotalSwapUsage))
+	}
+	return i, nil
+}
+
+func (m *MemoryOomControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoryOomControl) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OomKillDisable != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.OomKillDisable))
+	}
+	if m.UnderOom != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.UnderOom))
+	}
+	if m.OomKill != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.OomKill))
+	}
+	return i, nil
+}
+
+func (m *MemoryNumaNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoryNumaNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Node != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.Node))
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.Total))
+	}
+	if m.File != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.File))
+	}
+	if m.Anon != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.Anon))
+	}
+	if m.Unevictable != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.Unevictable))
+	}
+	if m.HierarchicalTotal != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.HierarchicalTotal))
+	}
+	if m.HierarchicalFile != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.HierarchicalFile))
+	}
+	if m.HierarchicalAnon != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.HierarchicalAnon))
+	}
+	if m.HierarchicalUnevictable != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.HierarchicalUnevictable))
+	}
 	return i, nil
 }
 
@@ -930,6 +1156,16 @@ func (m *BlkIOStat) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Hierarchical {
+		dAtA[i] = 0x48
+		i++
+		if m.Hierarchical {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1118,6 +1354,45 @@ func (m *NetworkStat) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MiscStat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MiscStat) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resource) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(len(m.Resource)))
+		i += copy(dAtA[i:], m.Resource)
+	}
+	if m.Current != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.Current))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.Limit))
+	}
+	if m.MaxEvents != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMetrics(dAtA, i, uint64(m.MaxEvents))
+	}
+	return i, nil
+}
+
 func encodeVarintMetrics(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1162,6 +1437,12 @@ func (m *Metrics) Size() (n int) {
 			n += 1 + l + sovMetrics(uint64(l))
 		}
 	}
+	if len(m.Misc) > 0 {
+		for _, e := range m.Misc {
+			l = e.Size()
+			n += 1 + l + sovMetrics(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1181,6 +1462,9 @@ func (m *HugetlbStat) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetrics(uint64(l))
 	}
+	if m.Limit != 0 {
+		n += 1 + sovMetrics(uint64(m.Limit))
+	}
 	return n
 }
 
@@ -1193,6 +1477,9 @@ func (m *PidsStat) Size() (n int) {
 	if m.Limit != 0 {
 		n += 1 + sovMetrics(uint64(m.Limit))
 	}
+	if m.MaxEvents != 0 {
+		n += 1 + sovMetrics(uint64(m.MaxEvents))
+	}
 	return n
 }
 
@@ -1362,6 +1649,76 @@ func (m *MemoryStat) Size() (n int) {
 		l = m.KernelTCP.Size()
 		n += 2 + l + sovMetrics(uint64(l))
 	}
+	if m.OomControl != nil {
+		l = m.OomControl.Size()
+		n += 2 + l + sovMetrics(uint64(l))
+	}
+	if len(m.NumaStat) > 0 {
+		for _, e := range m.NumaStat {
+			l = e.Size()
+			n += 2 + l + sovMetrics(uint64(l))
+		}
+	}
+	if m.Shmem != 0 {
+		n += 2 + sovMetrics(uint64(m.Shmem))
+	}
+	if m.SwapUsage != 0 {
+		n += 2 + sovMetrics(uint64(m.SwapUsage))
+	}
+	if m.TotalShmem != 0 {
+		n += 2 + sovMetrics(uint64(m.TotalShmem))
+	}
+	if m.TotalSwapUsage != 0 {
+		n += 2 + sovMetrics(uint64(m.TotalSwapUsage))
+	}
+	return n
+}
+
+func (m *MemoryOomControl) Size() (n int) {
+	var l int
+	_ = l
+	if m.OomKillDisable != 0 {
+		n += 1 + sovMetrics(uint64(m.OomKillDisable))
+	}
+	if m.UnderOom != 0 {
+		n += 1 + sovMetrics(uint64(m.UnderOom))
+	}
+	if m.OomKill != 0 {
+		n += 1 + sovMetrics(uint64(m.OomKill))
+	}
+	return n
+}
+
+func (m *MemoryNumaNode) Size() (n int) {
+	var l int
+	_ = l
+	if m.Node != 0 {
+		n += 1 + sovMetrics(uint64(m.Node))
+	}
+	if m.Total != 0 {
+		n += 1 + sovMetrics(uint64(m.Total))
+	}
+	if m.File != 0 {
+		n += 1 + sovMetrics(uint64(m.File))
+	}
+	if m.Anon != 0 {
+		n += 1 + sovMetrics(uint64(m.Anon))
+	}
+	if m.Unevictable != 0 {
+		n += 1 + sovMetrics(uint64(m.Unevictable))
+	}
+	if m.HierarchicalTotal != 0 {
+		n += 1 + sovMetrics(uint64(m.HierarchicalTotal))
+	}
+	if m.HierarchicalFile != 0 {
+		n += 1 + sovMetrics(uint64(m.HierarchicalFile))
+	}
+	if m.HierarchicalAnon != 0 {
+		n += 1 + sovMetrics(uint64(m.HierarchicalAnon))
+	}
+	if m.HierarchicalUnevictable != 0 {
+		n += 1 + sovMetrics(uint64(m.HierarchicalUnevictable))
+	}
 	return n
 }
 
@@ -1434,6 +1791,9 @@ func (m *BlkIOStat) Size() (n int) {
 			n += 1 + l + sovMetrics(uint64(l))
 		}
 	}
+	if m.Hierarchical {
+		n += 2
+	}
 	return n
 }
 
@@ -1528,6 +1888,25 @@ func (m *NetworkStat) Size() (n int) {
 	return n
 }
 
+func (m *MiscStat) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovMetrics(uint64(l))
+	}
+	if m.Current != 0 {
+		n += 1 + sovMetrics(uint64(m.Current))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovMetrics(uint64(m.Limit))
+	}
+	if m.MaxEvents != 0 {
+		n += 1 + sovMetrics(uint64(m.MaxEvents))
+	}
+	return n
+}
+
 func sovMetrics(x uint64) (n int) {
 	for {
 		n++
@@ -1553,6 +1932,7 @@ func (this *Metrics) String() string {
 		`Blkio:` + strings.Replace(fmt.Sprintf("%v", this.Blkio), "BlkIOStat", "BlkIOStat", 1) + `,`,
 		`Rdma:` + strings.Replace(fmt.Sprintf("%v", this.Rdma), "RdmaStat", "RdmaStat", 1) + `,`,
 		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "NetworkStat", "NetworkStat", 1) + `,`,
+		`Misc:` + strings.Replace(fmt.Sprintf("%v", this.Misc), "MiscStat", "MiscStat", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1566,6 +1946,7 @@ func (this *HugetlbStat) String() string {
 		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
 		`Failcnt:` + fmt.Sprintf("%v", this.Failcnt) + `,`,
 		`Pagesize:` + fmt.Sprintf("%v", this.Pagesize) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1577,6 +1958,7 @@ func (this *PidsStat) String() string {
 	s := strings.Join([]string{`&PidsStat{`,
 		`Current:` + fmt.Sprintf("%v", this.Current) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`MaxEvents:` + fmt.Sprintf("%v", this.MaxEvents) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1658,6 +2040,42 @@ func (this *MemoryStat) String() string {
 		`Swap:` + strings.Replace(fmt.Sprintf("%v", this.Swap), "MemoryEntry", "MemoryEntry", 1) + `,`,
 		`Kernel:` + strings.Replace(fmt.Sprintf("%v", this.Kernel), "MemoryEntry", "MemoryEntry", 1) + `,`,
 		`KernelTCP:` + strings.Replace(fmt.Sprintf("%v", this.KernelTCP), "MemoryEntry", "MemoryEntry", 1) + `,`,
+		`OomControl:` + strings.Replace(fmt.Sprintf("%v", this.OomControl), "MemoryOomControl", "MemoryOomControl", 1) + `,`,
+		`NumaStat:` + strings.Replace(fmt.Sprintf("%v", this.NumaStat), "MemoryNumaNode", "MemoryNumaNode", 1) + `,`,
+		`Shmem:` + fmt.Sprintf("%v", this.Shmem) + `,`,
+		`SwapUsage:` + fmt.Sprintf("%v", this.SwapUsage) + `,`,
+		`TotalShmem:` + fmt.Sprintf("%v", this.TotalShmem) + `,`,
+		`TotalSwapUsage:` + fmt.Sprintf("%v", this.TotalSwapUsage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemoryOomControl) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemoryOomControl{`,
+		`OomKillDisable:` + fmt.Sprintf("%v", this.OomKillDisable) + `,`,
+		`UnderOom:` + fmt.Sprintf("%v", this.UnderOom) + `,`,
+		`OomKill:` + fmt.Sprintf("%v", this.OomKill) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemoryNumaNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemoryNumaNode{`,
+		`Node:` + fmt.Sprintf("%v", this.Node) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`File:` + fmt.Sprintf("%v", this.File) + `,`,
+		`Anon:` + fmt.Sprintf("%v", this.Anon) + `,`,
+		`Unevictable:` + fmt.Sprintf("%v", this.Unevictable) + `,`,
+		`HierarchicalTotal:` + fmt.Sprintf("%v", this.HierarchicalTotal) + `,`,
+		`HierarchicalFile:` + fmt.Sprintf("%v", this.HierarchicalFile) + `,`,
+		`HierarchicalAnon:` + fmt.Sprintf("%v", this.HierarchicalAnon) + `,`,
+		`HierarchicalUnevictable:` + fmt.Sprintf("%v", this.HierarchicalUnevictable) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1688,6 +2106,7 @@ func (this *BlkIOStat) String() string {
 		`IoMergedRecursive:` + strings.Replace(fmt.Sprintf("%v", this.IoMergedRecursive), "BlkIOEntry", "BlkIOEntry", 1) + `,`,
 		`IoTimeRecursive:` + strings.Replace(fmt.Sprintf("%v", this.IoTimeRecursive), "BlkIOEntry", "BlkIOEntry", 1) + `,`,
 		`SectorsRecursive:` + strings.Replace(fmt.Sprintf("%v", this.SectorsRecursive), "BlkIOEntry", "BlkIOEntry", 1) + `,`,
+		`Hierarchical:` + fmt.Sprintf("%v", this.Hierarchical) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1747,14 +2166,27 @@ func (this *NetworkStat) String() string {
 	}, "")
 	return s
 }
-func valueToStringMetrics(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *MiscStat) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
-}
+	s := strings.Join([]string{`&MiscStat{`,
+		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
+		`Current:` + fmt.Sprintf("%v", this.Current) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`MaxEvents:` + fmt.Sprintf("%v", this.MaxEvents) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringMetrics(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
 func (m *Metrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2011,6 +2443,37 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Misc = append(m.Misc, &MiscStat{})
+			if err := m.Misc[len(m.Misc)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -2147,6 +2610,25 @@ func (m *HugetlbStat) Unmarshal(dAtA []byte) error {
 			}
 			m.Pagesize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -2235,6 +2717,25 @@ func (m *PidsStat) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvents", wireType)
+			}
+			m.MaxEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEvents |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -3417,6 +3918,146 @@ func (m *MemoryStat) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OomControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OomControl == nil {
+				m.OomControl = &MemoryOomControl{}
+			}
+			if err := m.OomControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumaStat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NumaStat = append(m.NumaStat, &MemoryNumaNode{})
+			if err := m.NumaStat[len(m.NumaStat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shmem", wireType)
+			}
+			m.Shmem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shmem |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapUsage", wireType)
+			}
+			m.SwapUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapUsage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShmem", wireType)
+			}
+			m.TotalShmem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalShmem |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSwapUsage", wireType)
+			}
+			m.TotalSwapUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSwapUsage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -3438,7 +4079,7 @@ func (m *MemoryStat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoryEntry) Unmarshal(dAtA []byte) error {
+func (m *MemoryOomControl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3461,17 +4102,17 @@ func (m *MemoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemoryOomControl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoryOomControl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OomKillDisable", wireType)
 			}
-			m.Limit = 0
+			m.OomKillDisable = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetrics
@@ -3481,16 +4122,16 @@ func (m *MemoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= (uint64(b) & 0x7F) << shift
+				m.OomKillDisable |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnderOom", wireType)
 			}
-			m.Usage = 0
+			m.UnderOom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetrics
@@ -3500,35 +4141,16 @@ func (m *MemoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Usage |= (uint64(b) & 0x7F) << shift
+				m.UnderOom |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
-			}
-			m.Max = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetrics
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Max |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OomKill", wireType)
 			}
-			m.Failcnt = 0
+			m.OomKill = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetrics
@@ -3538,7 +4160,7 @@ func (m *MemoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failcnt |= (uint64(b) & 0x7F) << shift
+				m.OomKill |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3564,7 +4186,7 @@ func (m *MemoryEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlkIOStat) Unmarshal(dAtA []byte) error {
+func (m *MemoryNumaNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3587,17 +4209,17 @@ func (m *BlkIOStat) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlkIOStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemoryNumaNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlkIOStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoryNumaNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceBytesRecursive", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
-			var msglen int
+			m.Node = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetrics
@@ -3607,28 +4229,16 @@ func (m *BlkIOStat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Node |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetrics
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IoServiceBytesRecursive = append(m.IoServiceBytesRecursive, &BlkIOEntry{})
-			if err := m.IoServiceBytesRecursive[len(m.IoServiceBytesRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoServicedRecursive", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var msglen int
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetrics
@@ -3638,15 +4248,374 @@ func (m *BlkIOStat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Total |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetrics
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			postIndex := iNdEx + msglen
+			m.File = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.File |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anon", wireType)
+			}
+			m.Anon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Anon |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unevictable", wireType)
+			}
+			m.Unevictable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unevictable |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HierarchicalTotal", wireType)
+			}
+			m.HierarchicalTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HierarchicalTotal |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HierarchicalFile", wireType)
+			}
+			m.HierarchicalFile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HierarchicalFile |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HierarchicalAnon", wireType)
+			}
+			m.HierarchicalAnon = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HierarchicalAnon |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HierarchicalUnevictable", wireType)
+			}
+			m.HierarchicalUnevictable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HierarchicalUnevictable |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetrics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetrics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			m.Usage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Usage |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failcnt", wireType)
+			}
+			m.Failcnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failcnt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetrics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlkIOStat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetrics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlkIOStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlkIOStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoServiceBytesRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IoServiceBytesRecursive = append(m.IoServiceBytesRecursive, &BlkIOEntry{})
+			if err := m.IoServiceBytesRecursive[len(m.IoServiceBytesRecursive)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoServicedRecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -3841,6 +4810,26 @@ func (m *BlkIOStat) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hierarchical", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hierarchical = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -4487,6 +5476,142 @@ func (m *NetworkStat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MiscStat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetrics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MiscStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MiscStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			m.Current = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Current |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvents", wireType)
+			}
+			m.MaxEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEvents |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetrics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMetrics(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4595,102 +5720,123 @@ var (
 func init() { proto.RegisterFile("github.com/containerd/cgroups/metrics.proto", fileDescriptorMetrics) }
 
 var fileDescriptorMetrics = []byte{
-	// 1549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4d, 0x6f, 0x1b, 0xb7,
-	0x16, 0x8d, 0x2c, 0xd9, 0xd2, 0x5c, 0xd9, 0x8e, 0x4d, 0x27, 0xce, 0xd8, 0x49, 0x2c, 0x47, 0xb6,
-	0xdf, 0xf3, 0x7b, 0x06, 0x64, 0xbc, 0x3c, 0x20, 0x68, 0xd2, 0x04, 0x45, 0xe4, 0x24, 0x48, 0xd0,
-	0xba, 0x51, 0x46, 0x36, 0xd2, 0xae, 0x06, 0xd4, 0x88, 0x19, 0xd1, 0x96, 0x86, 0x13, 0x0e, 0xc7,
-	0x96, 0xbb, 0xea, 0xa2, 0x40, 0x57, 0xfd, 0x33, 0xfd, 0x15, 0x59, 0x76, 0x53, 0xa0, 0xdd, 0x18,
-	0x8d, 0x7e, 0x49, 0x41, 0x72, 0x3e, 0xa8, 0x24, 0x8e, 0xab, 0xdd, 0x90, 0x3c, 0xe7, 0xdc, 0xcb,
-	0x3b, 0x87, 0xc3, 0x3b, 0xb0, 0xe3, 0x53, 0xd1, 0x8b, 0x3b, 0x0d, 0x8f, 0x0d, 0x76, 0x3d, 0x16,
-	0x08, 0x4c, 0x03, 0xc2, 0xbb, 0xbb, 0x9e, 0xcf, 0x59, 0x1c, 0x46, 0xbb, 0x03, 0x22, 0x38, 0xf5,
-	0xa2, 0x46, 0xc8, 0x99, 0x60, 0xc8, 0xa6, 0xac, 0x91, 0x83, 0x1a, 0x09, 0xa8, 0x71, 0xf2, 0xbf,
-	0xd5, 0x6b, 0x3e, 0xf3, 0x99, 0x02, 0xed, 0xca, 0x27, 0x8d, 0xaf, 0xff, 0x5a, 0x84, 0xf2, 0xbe,
-	0x56, 0x40, 0x5f, 0x41, 0xb9, 0x17, 0xfb, 0x44, 0xf4, 0x3b, 0x76, 0x61, 0xbd, 0xb8, 0x5d, 0xbd,
-	0xbb, 0xd5, 0xb8, 0x48, 0xad, 0xf1, 0x5c, 0x03, 0xdb, 0x02, 0x0b, 0x27, 0x65, 0xa1, 0x7b, 0x50,
-	0x0a, 0x69, 0x37, 0xb2, 0xa7, 0xd6, 0x0b, 0xdb, 0xd5, 0xbb, 0xf5, 0x8b, 0xd9, 0x2d, 0xda, 0x8d,
-	0x14, 0x55, 0xe1, 0xd1, 0x43, 0x28, 0x7a, 0x61, 0x6c, 0x17, 0x15, 0xed, 0xce, 0xc5, 0xb4, 0xbd,
-	0xd6, 0xa1, 0x64, 0x35, 0xcb, 0xa3, 0xf3, 0x5a, 0x71, 0xaf, 0x75, 0xe8, 0x48, 0x1a, 0x7a, 0x08,
-	0x33, 0x03, 0x32, 0x60, 0xfc, 0xcc, 0x2e, 0x29, 0x81, 0xcd, 0x8b, 0x05, 0xf6, 0x15, 0x4e, 0x45,
-	0x4e, 0x38, 0xe8, 0x3e, 0x4c, 0x77, 0xfa, 0xc7, 0x94, 0xd9, 0xd3, 0x8a, 0xbc, 0x71, 0x31, 0xb9,
-	0xd9, 0x3f, 0x7e, 0xf1, 0x52, 0x71, 0x35, 0x43, 0x6e, 0x97, 0x77, 0x07, 0xd8, 0x9e, 0xb9, 0x6c,
-	0xbb, 0x4e, 0x77, 0x80, 0xf5, 0x76, 0x25, 0x5e, 0xd6, 0x39, 0x20, 0xe2, 0x94, 0xf1, 0x63, 0xbb,
-	0x7c, 0x59, 0x9d, 0xbf, 0xd5, 0x40, 0x5d, 0xe7, 0x84, 0x55, 0x3f, 0x86, 0xaa, 0x51, 0x7f, 0x74,
-	0x0d, 0xa6, 0xe3, 0x08, 0xfb, 0xc4, 0x2e, 0xac, 0x17, 0xb6, 0x4b, 0x8e, 0x1e, 0xa0, 0x05, 0x28,
-	0x0e, 0xf0, 0x50, 0xbd, 0x8b, 0x92, 0x23, 0x1f, 0x91, 0x0d, 0xe5, 0x37, 0x98, 0xf6, 0xbd, 0x40,
-	0xa8, 0x52, 0x97, 0x9c, 0x74, 0x88, 0x56, 0xa1, 0x12, 0x62, 0x9f, 0x44, 0xf4, 0x07, 0xa2, 0x8a,
-	0x68, 0x39, 0xd9, 0xb8, 0xfe, 0x00, 0x2a, 0xe9, 0xeb, 0x92, 0x0a, 0x5e, 0xcc, 0x39, 0x09, 0x44,
-	0x12, 0x2b, 0x1d, 0xca, 0x1c, 0xfa, 0x74, 0x40, 0x45, 0x12, 0x4f, 0x0f, 0xea, 0x3f, 0x17, 0xa0,
-	0x9c, 0xbc, 0x34, 0xf4, 0x85, 0x99, 0xe5, 0x67, 0xcb, 0xb5, 0xd7, 0x3a, 0x3c, 0x94, 0xc8, 0x74,
-	0x27, 0x4d, 0x00, 0xd1, 0xe3, 0x4c, 0x88, 0x3e, 0x0d, 0xfc, 0xcb, 0xcd, 0x75, 0xa0, 0xb1, 0xc4,
-	0x31, 0x58, 0xf5, 0xb7, 0x50, 0x49, 0x65, 0x65, 0xae, 0x82, 0x09, 0xdc, 0x4f, 0xeb, 0xa5, 0x06,
-	0x68, 0x19, 0x66, 0x8e, 0x09, 0x0f, 0x48, 0x3f, 0xd9, 0x42, 0x32, 0x42, 0x08, 0x4a, 0x71, 0x44,
-	0x78, 0x52, 0x32, 0xf5, 0x8c, 0x36, 0xa0, 0x1c, 0x12, 0xee, 0x4a, 0xd3, 0x96, 0xd6, 0x8b, 0xdb,
-	0xa5, 0x26, 0x8c, 0xce, 0x6b, 0x33, 0x2d, 0xc2, 0xa5, 0x29, 0x67, 0x42, 0xc2, 0xf7, 0xc2, 0xb8,
-	0x3e, 0x84, 0x4a, 0x9a, 0x8a, 0x2c, 0x5c, 0x48, 0x38, 0x65, 0xdd, 0x28, 0x2d, 0x5c, 0x32, 0x44,
-	0x3b, 0xb0, 0x98, 0xa4, 0x49, 0xba, 0x6e, 0x8a, 0xd1, 0x19, 0x2c, 0x64, 0x0b, 0xad, 0x04, 0xbc,
-	0x05, 0xf3, 0x39, 0x58, 0xd0, 0x01, 0x49, 0xb2, 0x9a, 0xcb, 0x66, 0x0f, 0xe8, 0x80, 0xd4, 0xff,
-	0xac, 0x02, 0xe4, 0x56, 0x97, 0xfb, 0xf5, 0xb0, 0xd7, 0xcb, 0xfc, 0xa1, 0x06, 0x68, 0x05, 0x8a,
-	0x3c, 0x4a, 0x42, 0xe9, 0x13, 0xe5, 0xb4, 0xdb, 0x8e, 0x9c, 0x43, 0xff, 0x82, 0x0a, 0x8f, 0x22,
-	0x57, 0x1e, 0x6b, 0x1d, 0xa0, 0x59, 0x1d, 0x9d, 0xd7, 0xca, 0x4e, 0xbb, 0x2d, 0x6d, 0xe7, 0x94,
-	0x79, 0x14, 0xc9, 0x07, 0x54, 0x83, 0xea, 0x00, 0x87, 0x21, 0xe9, 0xba, 0x6f, 0x68, 0x5f, 0x3b,
-	0xa7, 0xe4, 0x80, 0x9e, 0x7a, 0x46, 0xfb, 0xaa, 0xd2, 0x5d, 0xca, 0xc5, 0x99, 0x3a, 0x5c, 0x25,
-	0x47, 0x0f, 0xd0, 0x2d, 0xb0, 0x4e, 0x39, 0x15, 0xa4, 0x83, 0xbd, 0x63, 0x75, 0x78, 0x4a, 0x4e,
-	0x3e, 0x81, 0x6c, 0xa8, 0x84, 0xbe, 0x1b, 0xfa, 0x2e, 0x0d, 0xec, 0xb2, 0x7e, 0x13, 0xa1, 0xdf,
-	0xf2, 0x5f, 0x04, 0x68, 0x15, 0x2c, 0xbd, 0xc2, 0x62, 0x61, 0x57, 0x92, 0x32, 0xfa, 0x2d, 0xff,
-	0x65, 0x2c, 0xd0, 0x8a, 0x62, 0xbd, 0xc1, 0x71, 0x5f, 0xd8, 0x56, 0xba, 0xf4, 0x4c, 0x0e, 0xd1,
-	0x3a, 0xcc, 0x86, 0xbe, 0x3b, 0xc0, 0x47, 0xc9, 0x32, 0xe8, 0x34, 0x43, 0x7f, 0x1f, 0x1f, 0x69,
-	0xc4, 0x06, 0xcc, 0xd1, 0x00, 0x7b, 0x82, 0x9e, 0x10, 0x17, 0x07, 0x2c, 0xb0, 0xab, 0x0a, 0x32,
-	0x9b, 0x4e, 0x3e, 0x0e, 0x58, 0x20, 0x37, 0x6b, 0x42, 0x66, 0xb5, 0x8a, 0x01, 0x30, 0x55, 0x54,
-	0x3d, 0xe6, 0xc6, 0x55, 0x54, 0x45, 0x72, 0x15, 0x05, 0x99, 0x37, 0x55, 0x14, 0x60, 0x1d, 0xaa,
-	0x71, 0x40, 0x4e, 0xa8, 0x27, 0x70, 0xa7, 0x4f, 0xec, 0xab, 0x0a, 0x60, 0x4e, 0xa1, 0x07, 0xb0,
-	0xd2, 0xa3, 0x84, 0x63, 0xee, 0xf5, 0xa8, 0x87, 0xfb, 0xae, 0xfe, 0x90, 0xb9, 0xfa, 0xf8, 0x2d,
-	0x28, 0xfc, 0x0d, 0x13, 0xa0, 0x9d, 0xf0, 0x8d, 0x5c, 0x46, 0xf7, 0x60, 0x6c, 0xc9, 0x8d, 0x4e,
-	0x71, 0x98, 0x30, 0x17, 0x15, 0xf3, 0xba, 0xb9, 0xdc, 0x3e, 0xc5, 0xa1, 0xe6, 0xd5, 0xa0, 0xaa,
-	0x4e, 0x89, 0xab, 0x8d, 0x84, 0x74, 0xda, 0x6a, 0x6a, 0x4f, 0xb9, 0xe9, 0x3f, 0x60, 0x69, 0x80,
-	0xf4, 0xd4, 0x92, 0xf2, 0xcc, 0xec, 0xe8, 0xbc, 0x56, 0x39, 0x90, 0x93, 0xd2, 0x58, 0x15, 0xb5,
-	0xec, 0x44, 0x11, 0xba, 0x07, 0xf3, 0x19, 0x54, 0x7b, 0xec, 0x9a, 0xc2, 0x2f, 0x8c, 0xce, 0x6b,
-	0xb3, 0x29, 0x5e, 0x19, 0x6d, 0x36, 0xe5, 0x28, 0xb7, 0xfd, 0x17, 0x16, 0x35, 0xcf, 0xf4, 0xdc,
-	0x75, 0x95, 0xc9, 0x55, 0xb5, 0xb0, 0x9f, 0x1b, 0x2f, 0xcb, 0x57, 0xdb, 0x6f, 0xd9, 0xc8, 0xf7,
-	0x89, 0xf2, 0xe0, 0xbf, 0x41, 0x73, 0xdc, 0xdc, 0x89, 0x37, 0x14, 0x48, 0xe7, 0xf6, 0x3a, 0xb3,
-	0xe3, 0x46, 0x9a, 0x6d, 0x66, 0x4a, 0x5b, 0xbf, 0x12, 0x35, 0xdb, 0xd2, 0xce, 0xdc, 0x4a, 0xd5,
-	0x72, 0x7f, 0xae, 0xe8, 0x97, 0x9f, 0xa1, 0xa4, 0x49, 0x37, 0x0d, 0x2d, 0xed, 0xc5, 0xd5, 0x31,
-	0x94, 0x76, 0xe3, 0x0e, 0xa0, 0x0c, 0x95, 0xbb, 0xf6, 0xa6, 0xb1, 0xd1, 0x56, 0x6e, 0xdd, 0x06,
-	0x2c, 0x69, 0xf0, 0xb8, 0x81, 0x6f, 0x29, 0xb4, 0xae, 0xd7, 0x0b, 0xd3, 0xc5, 0x59, 0x11, 0x4d,
-	0xf4, 0x6d, 0x43, 0xfb, 0x71, 0x8e, 0xfd, 0x58, 0x5b, 0x95, 0x7c, 0xed, 0x13, 0xda, 0xaa, 0xe8,
-	0x1f, 0x6a, 0x2b, 0x74, 0xed, 0x23, 0x6d, 0x85, 0xdd, 0x49, 0xb1, 0xa6, 0xd9, 0xd7, 0x93, 0xcf,
-	0x9e, 0x5c, 0x38, 0x34, 0x1c, 0xff, 0x65, 0x7a, 0x75, 0xdc, 0x51, 0xdf, 0xfe, 0xad, 0xcb, 0x2e,
-	0xf8, 0xa7, 0x81, 0xe0, 0x67, 0xe9, 0xed, 0x71, 0x1f, 0x4a, 0xd2, 0xe5, 0x76, 0x7d, 0x12, 0xae,
-	0xa2, 0xa0, 0x47, 0xd9, 0x95, 0xb0, 0x31, 0x09, 0x39, 0xbd, 0x39, 0xda, 0x00, 0xfa, 0xc9, 0x15,
-	0x5e, 0x68, 0x6f, 0x4e, 0x20, 0xd1, 0x9c, 0x1b, 0x9d, 0xd7, 0xac, 0xaf, 0x15, 0xf9, 0x60, 0xaf,
-	0xe5, 0x58, 0x5a, 0xe7, 0xc0, 0x0b, 0xeb, 0x04, 0xaa, 0x06, 0x30, 0xbf, 0x77, 0x0b, 0xc6, 0xbd,
-	0x9b, 0x77, 0x04, 0x53, 0x9f, 0xe8, 0x08, 0x8a, 0x9f, 0xec, 0x08, 0x4a, 0x63, 0x1d, 0x41, 0xfd,
-	0xf7, 0x69, 0xb0, 0xb2, 0x86, 0x07, 0x61, 0x58, 0xa5, 0xcc, 0x8d, 0x08, 0x3f, 0xa1, 0x1e, 0x71,
-	0x3b, 0x67, 0x82, 0x44, 0x2e, 0x27, 0x5e, 0xcc, 0x23, 0x7a, 0x42, 0x92, 0x66, 0x71, 0xf3, 0x92,
-	0xce, 0x49, 0xd7, 0xe6, 0x06, 0x65, 0x6d, 0x2d, 0xd3, 0x94, 0x2a, 0x4e, 0x2a, 0x82, 0xbe, 0x83,
-	0xeb, 0x79, 0x88, 0xae, 0xa1, 0x3e, 0x35, 0x81, 0xfa, 0x52, 0xa6, 0xde, 0xcd, 0x95, 0x0f, 0x60,
-	0x89, 0x32, 0xf7, 0x6d, 0x4c, 0xe2, 0x31, 0xdd, 0xe2, 0x04, 0xba, 0x8b, 0x94, 0xbd, 0x52, 0xfc,
-	0x5c, 0xd5, 0x85, 0x15, 0xa3, 0x24, 0xf2, 0x2e, 0x36, 0xb4, 0x4b, 0x13, 0x68, 0x2f, 0x67, 0x39,
-	0xcb, 0xbb, 0x3b, 0x0f, 0xf0, 0x3d, 0x2c, 0x53, 0xe6, 0x9e, 0x62, 0x2a, 0x3e, 0x54, 0x9f, 0x9e,
-	0xac, 0x22, 0xaf, 0x31, 0x15, 0xe3, 0xd2, 0xba, 0x22, 0x03, 0xc2, 0xfd, 0xb1, 0x8a, 0xcc, 0x4c,
-	0x56, 0x91, 0x7d, 0xc5, 0xcf, 0x55, 0x5b, 0xb0, 0x48, 0xd9, 0x87, 0xb9, 0x96, 0x27, 0xd0, 0xbc,
-	0x4a, 0xd9, 0x78, 0x9e, 0xaf, 0x60, 0x31, 0x22, 0x9e, 0x60, 0xdc, 0x74, 0x5b, 0x65, 0x02, 0xc5,
-	0x85, 0x84, 0x9e, 0x49, 0xd6, 0x4f, 0x00, 0xf2, 0x75, 0x34, 0x0f, 0x53, 0x2c, 0x54, 0x47, 0xc7,
-	0x72, 0xa6, 0x58, 0x28, 0x7b, 0xc0, 0xae, 0xfc, 0xec, 0xe8, 0x83, 0x63, 0x39, 0xc9, 0x48, 0x9e,
-	0xa7, 0x01, 0x3e, 0x62, 0x69, 0x13, 0xa8, 0x07, 0x6a, 0x96, 0x06, 0x8c, 0x27, 0x67, 0x47, 0x0f,
-	0xe4, 0xec, 0x09, 0xee, 0xc7, 0x24, 0xed, 0x79, 0xd4, 0xa0, 0xfe, 0x53, 0x01, 0x2a, 0xe9, 0x6f,
-	0x00, 0x7a, 0x64, 0xb6, 0xd1, 0xc5, 0xcf, 0xff, 0x75, 0x48, 0x92, 0xde, 0x4c, 0xd6, 0x6b, 0xdf,
-	0xcf, 0x7b, 0xed, 0x7f, 0x4c, 0x4e, 0x1a, 0x72, 0x02, 0x56, 0x36, 0x67, 0xec, 0xb6, 0x30, 0xb6,
-	0xdb, 0x1a, 0x54, 0x7b, 0x1e, 0x76, 0x7b, 0x38, 0xe8, 0xf6, 0x89, 0xee, 0x10, 0xe7, 0x1c, 0xe8,
-	0x79, 0xf8, 0xb9, 0x9e, 0x49, 0x01, 0xac, 0x73, 0x44, 0x3c, 0x11, 0xa9, 0xa2, 0x68, 0xc0, 0x4b,
-	0x3d, 0x53, 0xff, 0x65, 0x0a, 0xaa, 0xc6, 0x9f, 0x8b, 0xec, 0xa1, 0x03, 0x3c, 0x48, 0xe3, 0xa8,
-	0x67, 0xd9, 0xb1, 0xf1, 0xa1, 0xfe, 0x96, 0x24, 0x9f, 0xa9, 0x32, 0x1f, 0xaa, 0x8f, 0x02, 0xba,
-	0x0d, 0xc0, 0x87, 0x6e, 0x88, 0xbd, 0x63, 0x92, 0xc8, 0x97, 0x1c, 0x8b, 0x0f, 0x5b, 0x7a, 0x02,
-	0xdd, 0x04, 0x8b, 0x0f, 0x5d, 0xc2, 0x39, 0xe3, 0x51, 0x52, 0xfb, 0x0a, 0x1f, 0x3e, 0x55, 0xe3,
-	0x84, 0xdb, 0xe5, 0x4c, 0xf6, 0x02, 0xc9, 0x3b, 0xb0, 0xf8, 0xf0, 0x89, 0x9e, 0x90, 0x51, 0x45,
-	0x1a, 0x55, 0xb7, 0x9e, 0x65, 0x91, 0x47, 0x15, 0x79, 0x54, 0xdd, 0x7a, 0x5a, 0xc2, 0x8c, 0x2a,
-	0xb2, 0xa8, 0xba, 0xfb, 0xac, 0x08, 0x23, 0xaa, 0xc8, 0xa3, 0x5a, 0x29, 0x37, 0x89, 0xda, 0xb4,
-	0xdf, 0xbd, 0x5f, 0xbb, 0xf2, 0xc7, 0xfb, 0xb5, 0x2b, 0x3f, 0x8e, 0xd6, 0x0a, 0xef, 0x46, 0x6b,
-	0x85, 0xdf, 0x46, 0x6b, 0x85, 0xbf, 0x46, 0x6b, 0x85, 0xce, 0x8c, 0xfa, 0x0d, 0xff, 0xff, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x19, 0x9d, 0xe2, 0xd3, 0xe5, 0x0f, 0x00, 0x00,
+	// 1881 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x5d, 0x6f, 0x1c, 0xb7,
+	0xd5, 0xce, 0x7e, 0x48, 0x3b, 0x7b, 0x56, 0x92, 0x25, 0xfa, 0x6b, 0xac, 0x24, 0x5a, 0x65, 0x65,
+	0x27, 0x8a, 0x8d, 0x57, 0xc6, 0x9b, 0x02, 0x46, 0xdd, 0x26, 0x28, 0x22, 0xd9, 0x41, 0x0c, 0x57,
+	0xd6, 0x66, 0x56, 0x42, 0x9a, 0xab, 0x01, 0x35, 0x4b, 0xef, 0xd2, 0x9a, 0x19, 0x4e, 0x38, 0x1c,
+	0x69, 0xdd, 0xab, 0x16, 0x28, 0xd0, 0xab, 0xfe, 0x2f, 0x5f, 0xf6, 0xb2, 0x57, 0x42, 0xb3, 0x97,
+	0x05, 0xfa, 0x03, 0x7a, 0x57, 0x90, 0x67, 0x3e, 0xb8, 0x92, 0x65, 0x75, 0xef, 0xc8, 0xc3, 0xe7,
+	0x79, 0x48, 0x9e, 0x39, 0x87, 0x87, 0x1c, 0x78, 0x34, 0xe2, 0x6a, 0x9c, 0x1d, 0xef, 0x04, 0x22,
+	0x7a, 0x1c, 0x88, 0x58, 0x51, 0x1e, 0x33, 0x39, 0x7c, 0x1c, 0x8c, 0xa4, 0xc8, 0x92, 0xf4, 0x71,
+	0xc4, 0x94, 0xe4, 0x41, 0xba, 0x93, 0x48, 0xa1, 0x04, 0x71, 0xb9, 0xd8, 0xa9, 0x40, 0x3b, 0x39,
+	0x68, 0xe7, 0xf4, 0xff, 0xd7, 0x6f, 0x8d, 0xc4, 0x48, 0x18, 0xd0, 0x63, 0xdd, 0x42, 0x7c, 0xef,
+	0x5f, 0x0d, 0x68, 0xed, 0xa3, 0x02, 0xf9, 0x1d, 0xb4, 0xc6, 0xd9, 0x88, 0xa9, 0xf0, 0xd8, 0xad,
+	0x6d, 0x36, 0xb6, 0x3b, 0x5f, 0x3d, 0xd8, 0xb9, 0x4a, 0x6d, 0xe7, 0x7b, 0x04, 0x0e, 0x14, 0x55,
+	0x5e, 0xc1, 0x22, 0x4f, 0xa0, 0x99, 0xf0, 0x61, 0xea, 0xd6, 0x37, 0x6b, 0xdb, 0x9d, 0xaf, 0x7a,
+	0x57, 0xb3, 0xfb, 0x7c, 0x98, 0x1a, 0xaa, 0xc1, 0x93, 0xaf, 0xa1, 0x11, 0x24, 0x99, 0xdb, 0x30,
+	0xb4, 0xcf, 0xae, 0xa6, 0xed, 0xf5, 0x8f, 0x34, 0x6b, 0xb7, 0x35, 0x3d, 0xef, 0x36, 0xf6, 0xfa,
+	0x47, 0x9e, 0xa6, 0x91, 0xaf, 0x61, 0x31, 0x62, 0x91, 0x90, 0x6f, 0xdd, 0xa6, 0x11, 0xb8, 0x7f,
+	0xb5, 0xc0, 0xbe, 0xc1, 0x99, 0x99, 0x73, 0x0e, 0x79, 0x0a, 0x0b, 0xc7, 0xe1, 0x09, 0x17, 0xee,
+	0x82, 0x21, 0x6f, 0x5d, 0x4d, 0xde, 0x0d, 0x4f, 0x5e, 0x1c, 0x18, 0x2e, 0x32, 0xf4, 0x76, 0xe5,
+	0x30, 0xa2, 0xee, 0xe2, 0x75, 0xdb, 0xf5, 0x86, 0x11, 0xc5, 0xed, 0x6a, 0xbc, 0xf6, 0x73, 0xcc,
+	0xd4, 0x99, 0x90, 0x27, 0x6e, 0xeb, 0x3a, 0x3f, 0xbf, 0x42, 0x20, 0xfa, 0x39, 0x67, 0xe9, 0x89,
+	0x23, 0x9e, 0x06, 0xae, 0xb3, 0xd9, 0xf8, 0xf0, 0xc4, 0xfb, 0x3c, 0x0d, 0x70, 0x62, 0x8d, 0xef,
+	0xfd, 0xb9, 0x06, 0x1d, 0xeb, 0xc3, 0x91, 0x5b, 0xb0, 0x90, 0xa5, 0x74, 0xc4, 0xdc, 0xda, 0x66,
+	0x6d, 0xbb, 0xe9, 0x61, 0x87, 0xac, 0x42, 0x23, 0xa2, 0x13, 0xf3, 0x11, 0x9b, 0x9e, 0x6e, 0x12,
+	0x17, 0x5a, 0xaf, 0x29, 0x0f, 0x83, 0x58, 0x99, 0x6f, 0xd4, 0xf4, 0x8a, 0x2e, 0x59, 0x07, 0x27,
+	0xa1, 0x23, 0x96, 0xf2, 0x3f, 0x32, 0xe3, 0xfd, 0xb6, 0x57, 0xf6, 0xb5, 0x7a, 0xc8, 0x23, 0xae,
+	0x8c, 0x67, 0x9b, 0x1e, 0x76, 0x7a, 0x3f, 0x81, 0x53, 0x7c, 0x7d, 0xad, 0x1b, 0x64, 0x52, 0xb2,
+	0x58, 0xe5, 0x2b, 0x28, 0xba, 0x15, 0xb7, 0x6e, 0x71, 0xc9, 0xa7, 0x00, 0x11, 0x9d, 0xf8, 0xec,
+	0x94, 0xc5, 0x2a, 0xcd, 0x97, 0xd2, 0x8e, 0xe8, 0xe4, 0xb9, 0x31, 0xf4, 0xfe, 0x5a, 0x83, 0x56,
+	0x1e, 0x22, 0xe4, 0xd7, 0xf6, 0xd6, 0x3e, 0xe8, 0xa3, 0xbd, 0xfe, 0xd1, 0x91, 0x46, 0x16, 0xdb,
+	0xdf, 0x05, 0x50, 0x63, 0x29, 0x94, 0x0a, 0x79, 0x3c, 0xba, 0x3e, 0x94, 0x0f, 0x11, 0xcb, 0x3c,
+	0x8b, 0xd5, 0xfb, 0x19, 0x9c, 0x42, 0x56, 0x6f, 0x45, 0x09, 0x45, 0xc3, 0xc2, 0xc9, 0xa6, 0x43,
+	0xee, 0xc0, 0xe2, 0x09, 0x93, 0x31, 0x0b, 0xf3, 0x1d, 0xe6, 0x3d, 0x42, 0xa0, 0x99, 0xa5, 0x4c,
+	0xe6, 0x9b, 0x33, 0x6d, 0xb2, 0x05, 0xad, 0x84, 0x49, 0x5f, 0xa7, 0x48, 0x73, 0xb3, 0xb1, 0xdd,
+	0xdc, 0x85, 0xe9, 0x79, 0x77, 0xb1, 0xcf, 0xa4, 0x4e, 0x81, 0xc5, 0x84, 0xc9, 0xbd, 0x24, 0xeb,
+	0x4d, 0xc0, 0x29, 0x96, 0xa2, 0xfd, 0x9a, 0x30, 0xc9, 0xc5, 0x30, 0x2d, 0xfc, 0x9a, 0x77, 0xc9,
+	0x23, 0x58, 0xcb, 0x97, 0xc9, 0x86, 0x7e, 0x81, 0xc1, 0x15, 0xac, 0x96, 0x03, 0xfd, 0x1c, 0xfc,
+	0x00, 0x56, 0x2a, 0xb0, 0xe2, 0x11, 0xcb, 0x57, 0xb5, 0x5c, 0x5a, 0x0f, 0x79, 0xc4, 0x7a, 0xff,
+	0x5e, 0x06, 0xa8, 0x12, 0x4b, 0xef, 0x37, 0xa0, 0xc1, 0xb8, 0x0c, 0x2a, 0xd3, 0x21, 0xf7, 0xa0,
+	0x21, 0xd3, 0x7c, 0x2a, 0xcc, 0x5f, 0x6f, 0x30, 0xf0, 0xb4, 0x8d, 0x7c, 0x0e, 0x8e, 0x4c, 0x53,
+	0x5f, 0x1f, 0x22, 0x38, 0xc1, 0x6e, 0x67, 0x7a, 0xde, 0x6d, 0x79, 0x83, 0x81, 0x8e, 0x55, 0xaf,
+	0x25, 0xd3, 0x54, 0x37, 0x48, 0x17, 0x3a, 0x11, 0x4d, 0x12, 0x36, 0xf4, 0x5f, 0xf3, 0x10, 0xc3,
+	0xad, 0xe9, 0x01, 0x9a, 0xbe, 0xe3, 0xa1, 0xf1, 0xf4, 0x90, 0x4b, 0xf5, 0xb6, 0x08, 0x38, 0xd3,
+	0x21, 0x9f, 0x40, 0xfb, 0x4c, 0x72, 0xc5, 0x8e, 0x69, 0x70, 0x62, 0x52, 0xb5, 0xe9, 0x55, 0x06,
+	0xe2, 0x82, 0x93, 0x8c, 0xfc, 0x64, 0xe4, 0xf3, 0xd8, 0x6d, 0xe1, 0x97, 0x48, 0x46, 0xfd, 0xd1,
+	0x8b, 0x98, 0xac, 0x43, 0x1b, 0x47, 0x44, 0xa6, 0x5c, 0x27, 0x77, 0xe3, 0xa8, 0x3f, 0x3a, 0xc8,
+	0x14, 0xb9, 0x67, 0x58, 0xaf, 0x69, 0x16, 0x2a, 0xb7, 0x5d, 0x0c, 0x7d, 0xa7, 0xbb, 0x64, 0x13,
+	0x96, 0x92, 0x91, 0x1f, 0xd1, 0x37, 0xf9, 0x30, 0xe0, 0x32, 0x93, 0xd1, 0x3e, 0x7d, 0x83, 0x88,
+	0x2d, 0x58, 0xe6, 0x31, 0x0d, 0x14, 0x3f, 0x65, 0x3e, 0x8d, 0x45, 0xec, 0x76, 0x0c, 0x64, 0xa9,
+	0x30, 0x7e, 0x1b, 0x8b, 0x58, 0x6f, 0xd6, 0x86, 0x2c, 0xa1, 0x8a, 0x05, 0xb0, 0x55, 0x8c, 0x3f,
+	0x96, 0x67, 0x55, 0x8c, 0x47, 0x2a, 0x15, 0x03, 0x59, 0xb1, 0x55, 0x0c, 0x60, 0x13, 0x3a, 0x59,
+	0xcc, 0x4e, 0x79, 0xa0, 0xe8, 0x71, 0xc8, 0xdc, 0x1b, 0x06, 0x60, 0x9b, 0xc8, 0x6f, 0xe0, 0xde,
+	0x98, 0x33, 0x49, 0x65, 0x30, 0xe6, 0x01, 0x0d, 0x7d, 0x3c, 0x36, 0x7d, 0xcc, 0xce, 0x55, 0x83,
+	0xbf, 0x6b, 0x03, 0x30, 0x12, 0x7e, 0xaf, 0x87, 0xc9, 0x13, 0x98, 0x19, 0xf2, 0xd3, 0x33, 0x9a,
+	0xe4, 0xcc, 0x35, 0xc3, 0xbc, 0x6d, 0x0f, 0x0f, 0xce, 0x68, 0x82, 0xbc, 0x2e, 0x74, 0x4c, 0x96,
+	0xf8, 0x18, 0x48, 0x04, 0x97, 0x6d, 0x4c, 0x7b, 0xda, 0x42, 0xbe, 0x84, 0x36, 0x02, 0x74, 0x4c,
+	0xdd, 0x34, 0x31, 0xb3, 0x34, 0x3d, 0xef, 0x3a, 0x87, 0xda, 0xa8, 0x03, 0xcb, 0x31, 0xc3, 0x5e,
+	0x9a, 0x92, 0x27, 0xb0, 0x52, 0x42, 0x31, 0xc6, 0x6e, 0x19, 0xfc, 0xea, 0xf4, 0xbc, 0xbb, 0x54,
+	0xe0, 0x4d, 0xa0, 0x2d, 0x15, 0x1c, 0xdd, 0x23, 0x0f, 0x61, 0x0d, 0x79, 0x76, 0xcc, 0xdd, 0x36,
+	0x2b, 0xb9, 0x61, 0x06, 0xf6, 0xab, 0xc0, 0x2b, 0xd7, 0x8b, 0xe1, 0x77, 0xc7, 0x5a, 0xef, 0x33,
+	0x6d, 0x21, 0x5f, 0x00, 0x72, 0xfc, 0x2a, 0x12, 0xef, 0x1a, 0x10, 0xae, 0xed, 0xc7, 0xc2, 0x4a,
+	0xb6, 0x8a, 0xd5, 0x96, 0x41, 0xe9, 0xe2, 0x27, 0x31, 0xd6, 0x3e, 0x46, 0xe6, 0x03, 0xb8, 0x61,
+	0x83, 0x74, 0x7c, 0xde, 0xc3, 0x8f, 0x5f, 0xa2, 0x74, 0x90, 0xde, 0xb7, 0xb4, 0x30, 0x16, 0xd7,
+	0x67, 0x50, 0x18, 0x8d, 0x8f, 0x80, 0x94, 0xa8, 0x2a, 0x6a, 0x3f, 0xb6, 0x36, 0xda, 0xaf, 0x42,
+	0x77, 0x07, 0x6e, 0x22, 0x78, 0x36, 0x80, 0x3f, 0x31, 0x68, 0xf4, 0xd7, 0x0b, 0x3b, 0x8a, 0x4b,
+	0x27, 0xda, 0xe8, 0x4f, 0x2d, 0xed, 0x6f, 0x2b, 0xec, 0x65, 0x6d, 0xe3, 0xf2, 0x8d, 0xf7, 0x68,
+	0x1b, 0xa7, 0x5f, 0xd4, 0x36, 0xe8, 0xee, 0x25, 0x6d, 0x83, 0x7d, 0x54, 0x60, 0xed, 0x60, 0xdf,
+	0xcc, 0x8f, 0x3d, 0x3d, 0x70, 0x54, 0xd9, 0xc9, 0x6f, 0x8b, 0xd2, 0xf1, 0xd9, 0x66, 0xed, 0xc3,
+	0xc5, 0x19, 0x63, 0xfd, 0x79, 0xac, 0xe4, 0xdb, 0xa2, 0x7a, 0x3c, 0x85, 0xa6, 0x8e, 0x72, 0xb7,
+	0x37, 0x0f, 0xd7, 0x50, 0xc8, 0x37, 0x65, 0x49, 0xd8, 0x9a, 0x87, 0x9c, 0x93, 0xc8, 0x00, 0x00,
+	0x5b, 0xbe, 0x0a, 0x12, 0xf7, 0xfe, 0x1c, 0x12, 0xbb, 0xcb, 0xd3, 0xf3, 0x6e, 0xfb, 0xa5, 0x21,
+	0x1f, 0xee, 0xf5, 0xbd, 0x36, 0xea, 0x1c, 0x06, 0x09, 0x79, 0x09, 0x1d, 0x21, 0x22, 0x5f, 0x4b,
+	0x48, 0x11, 0xba, 0x0f, 0x8c, 0xea, 0xc3, 0xeb, 0x54, 0x0f, 0x44, 0xb4, 0x87, 0x0c, 0x0f, 0x44,
+	0xd9, 0x26, 0xcf, 0xa1, 0x1d, 0x67, 0x11, 0xf5, 0x53, 0x45, 0x95, 0xfb, 0xb9, 0xb9, 0xbb, 0x6c,
+	0x5f, 0x27, 0xf5, 0x2a, 0x8b, 0xe8, 0x2b, 0x31, 0x64, 0x9e, 0xa3, 0xa9, 0x45, 0x81, 0x49, 0xc7,
+	0x11, 0x8b, 0xdc, 0x2f, 0xf0, 0x98, 0x37, 0x1d, 0x7d, 0x37, 0x30, 0xc7, 0x0b, 0x7e, 0xba, 0x6d,
+	0x3c, 0xe7, 0xb5, 0x05, 0xab, 0x70, 0x99, 0xa2, 0x48, 0xfd, 0xd2, 0x4a, 0xd1, 0x81, 0xe1, 0x6f,
+	0xc3, 0x6a, 0x0e, 0xa8, 0x54, 0x1e, 0x5a, 0x39, 0x3a, 0x28, 0xa4, 0x7a, 0x0a, 0x56, 0x2f, 0x6e,
+	0x53, 0xb3, 0xb5, 0x9f, 0x4e, 0x78, 0xa8, 0x0f, 0x81, 0xd4, 0xc4, 0x17, 0xd6, 0xbf, 0x15, 0x21,
+	0xa2, 0x97, 0x3c, 0x0c, 0x9f, 0xa1, 0x95, 0x7c, 0x0c, 0xed, 0x2c, 0x1e, 0x32, 0xe9, 0x0b, 0x11,
+	0xe5, 0x95, 0xd7, 0x31, 0x86, 0x03, 0x11, 0xe9, 0xba, 0x52, 0xc8, 0x14, 0x37, 0xad, 0x9c, 0xde,
+	0x7b, 0x57, 0x87, 0x95, 0x59, 0x97, 0xe8, 0xbb, 0x42, 0x2c, 0x86, 0x38, 0xd1, 0xb2, 0x67, 0xda,
+	0xd5, 0x6d, 0xa3, 0x6e, 0xdf, 0x36, 0x08, 0x34, 0x4d, 0x7a, 0xe4, 0xb7, 0x0a, 0xdd, 0xd6, 0x36,
+	0x93, 0x8e, 0x58, 0x47, 0x4d, 0xfb, 0x62, 0x39, 0x58, 0xb8, 0x5c, 0x0e, 0xfe, 0x0f, 0xc8, 0xcc,
+	0x91, 0x8e, 0x93, 0x61, 0x59, 0x5d, 0xb3, 0x47, 0xcc, 0xd1, 0xaa, 0x13, 0x6f, 0x06, 0x6e, 0x56,
+	0x81, 0x75, 0x76, 0xd5, 0x1e, 0x28, 0xb2, 0x74, 0x06, 0x6c, 0x96, 0xe7, 0x5c, 0x06, 0x9b, 0xe3,
+	0xe2, 0x29, 0xb8, 0x33, 0x60, 0x7b, 0xdd, 0xed, 0xcb, 0x65, 0xc9, 0x4a, 0xf0, 0x1e, 0x83, 0x8e,
+	0x15, 0xfd, 0xd5, 0x5d, 0xb3, 0x66, 0xdf, 0x35, 0xcb, 0xbb, 0x71, 0xfd, 0x3d, 0x77, 0xe3, 0xc6,
+	0x7b, 0xef, 0xc6, 0xcd, 0x99, 0xbb, 0x71, 0xef, 0x3f, 0x0b, 0xd0, 0x2e, 0xdf, 0x0c, 0x84, 0xc2,
+	0x3a, 0x17, 0x7e, 0xca, 0xe4, 0x29, 0x0f, 0x98, 0x7f, 0xfc, 0x56, 0xb1, 0xd4, 0x97, 0x2c, 0xc8,
+	0x64, 0xca, 0x4f, 0x59, 0xfe, 0xde, 0xba, 0x7f, 0xcd, 0xe3, 0x03, 0x13, 0xfe, 0x2e, 0x17, 0x03,
+	0x94, 0xd9, 0xd5, 0x2a, 0x5e, 0x21, 0x42, 0xfe, 0x00, 0xb7, 0xab, 0x29, 0x86, 0x96, 0x7a, 0x7d,
+	0x0e, 0xf5, 0x9b, 0xa5, 0xfa, 0xb0, 0x52, 0x3e, 0x84, 0x9b, 0x5c, 0xf8, 0x3f, 0x67, 0x2c, 0x9b,
+	0xd1, 0x6d, 0xcc, 0xa1, 0xbb, 0xc6, 0xc5, 0x0f, 0x86, 0x5f, 0xa9, 0xfa, 0x70, 0xcf, 0x72, 0x89,
+	0xbe, 0x60, 0x5a, 0xda, 0xcd, 0x39, 0xb4, 0xef, 0x94, 0x6b, 0xd6, 0x17, 0xd2, 0x6a, 0x82, 0x9f,
+	0xe0, 0x0e, 0x17, 0xfe, 0x19, 0xe5, 0xea, 0xa2, 0xfa, 0xc2, 0x7c, 0x1e, 0xf9, 0x91, 0x72, 0x35,
+	0x2b, 0x8d, 0x1e, 0x89, 0x98, 0x1c, 0xcd, 0x78, 0x64, 0x71, 0x3e, 0x8f, 0xec, 0x1b, 0x7e, 0xa5,
+	0xda, 0x87, 0x35, 0x2e, 0x2e, 0xae, 0xb5, 0x35, 0x87, 0xe6, 0x0d, 0x2e, 0x66, 0xd7, 0xf9, 0x03,
+	0xac, 0xa5, 0x2c, 0x50, 0x42, 0xda, 0xd1, 0xe6, 0xcc, 0xa1, 0xb8, 0x9a, 0xd3, 0x2b, 0xc9, 0x1e,
+	0x2c, 0xd9, 0x99, 0x65, 0xb2, 0xcd, 0xf1, 0x66, 0x6c, 0xbd, 0x53, 0x80, 0x4a, 0x83, 0xac, 0x40,
+	0x5d, 0x24, 0x26, 0xbd, 0xda, 0x5e, 0x5d, 0x24, 0xfa, 0xf1, 0x33, 0xd4, 0xe9, 0x88, 0xc9, 0xd5,
+	0xf6, 0xf2, 0x9e, 0xce, 0xb9, 0x88, 0xbe, 0x11, 0xc5, 0xeb, 0x07, 0x3b, 0xc6, 0xca, 0x63, 0x21,
+	0xf3, 0xfc, 0xc2, 0x8e, 0xb6, 0x9e, 0xd2, 0x30, 0x2b, 0x0e, 0x29, 0xec, 0xf4, 0xfe, 0x52, 0x03,
+	0xa7, 0x78, 0x6d, 0x93, 0x6f, 0xec, 0xe7, 0x65, 0xe3, 0xc3, 0x8f, 0x7b, 0x4d, 0xc2, 0x0d, 0x17,
+	0x1c, 0xfd, 0x67, 0xa0, 0x78, 0x83, 0xfe, 0xcf, 0xe4, 0xfc, 0x91, 0xcb, 0xa0, 0x5d, 0xda, 0xac,
+	0xdd, 0xd6, 0x66, 0x76, 0xdb, 0x85, 0xce, 0x38, 0xa0, 0xfe, 0x98, 0xc6, 0xc3, 0x90, 0xe1, 0xd3,
+	0x68, 0xd9, 0x83, 0x71, 0x40, 0xbf, 0x47, 0x4b, 0x01, 0x10, 0xc7, 0x6f, 0x58, 0x90, 0xbf, 0x77,
+	0x11, 0x70, 0x80, 0x96, 0xde, 0xdf, 0xea, 0xd0, 0xb1, 0x7e, 0x10, 0x98, 0x82, 0x40, 0xa3, 0x62,
+	0x1e, 0xd3, 0xd6, 0x25, 0x45, 0x4e, 0xf0, 0xbc, 0xc9, 0x8f, 0xb2, 0x96, 0x9c, 0x98, 0x83, 0x43,
+	0x97, 0x4c, 0x39, 0xf1, 0x13, 0x1a, 0x9c, 0xb0, 0xea, 0x39, 0x2d, 0x27, 0x7d, 0x34, 0xe8, 0x4a,
+	0x25, 0x27, 0x3e, 0x93, 0x52, 0xc8, 0x34, 0xf7, 0xbd, 0x23, 0x27, 0xcf, 0x4d, 0x3f, 0xe7, 0x0e,
+	0xa5, 0xd0, 0x97, 0xe0, 0xfc, 0x1b, 0xb4, 0xe5, 0xe4, 0x19, 0x1a, 0xf4, 0xac, 0xaa, 0x98, 0x15,
+	0x8b, 0x43, 0x4b, 0x55, 0xb3, 0xaa, 0x6a, 0x56, 0xac, 0x05, 0x6d, 0x65, 0xcf, 0xaa, 0xca, 0x59,
+	0xf1, 0xf0, 0x77, 0x94, 0x35, 0xab, 0xaa, 0x66, 0x6d, 0x17, 0xdc, 0x7c, 0xd6, 0x5e, 0x06, 0x4e,
+	0xf1, 0xc7, 0x43, 0xff, 0x99, 0x90, 0x2c, 0x15, 0x99, 0x2c, 0xfd, 0x5e, 0xf6, 0xed, 0xff, 0x0e,
+	0xf5, 0x2b, 0xfe, 0x3b, 0x34, 0xae, 0xfe, 0xef, 0xd0, 0xbc, 0xf0, 0xdf, 0x61, 0xd7, 0x7d, 0xf7,
+	0xcb, 0xc6, 0x47, 0xff, 0xf8, 0x65, 0xe3, 0xa3, 0x3f, 0x4d, 0x37, 0x6a, 0xef, 0xa6, 0x1b, 0xb5,
+	0xbf, 0x4f, 0x37, 0x6a, 0xff, 0x9c, 0x6e, 0xd4, 0x8e, 0x17, 0xcd, 0x4f, 0xb6, 0x5f, 0xfd, 0x77,
+	0x00, 0xf2, 0x93, 0xd9, 0x1a, 0xc3, 0x13, 0x00, 0x00,
 }
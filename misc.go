@@ -0,0 +1,124 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func NewMisc(root string) *miscController {
+	return &miscController{
+		root: filepath.Join(root, string(Misc)),
+	}
+}
+
+type miscController struct {
+	root string
+}
+
+func (m *miscController) Name() Name {
+	return Misc
+}
+
+func (m *miscController) Path(path string) string {
+	return filepath.Join(m.root, path)
+}
+
+// SetLimits writes the limit of each resource to misc.max, math.MaxUint64
+// removes the limit
+func (m *miscController) SetLimits(path string, limits map[string]uint64) error {
+	for resource, limit := range limits {
+		v := "max"
+		if limit != math.MaxUint64 {
+			v = strconv.FormatUint(limit, 10)
+		}
+		if err := ioutil.WriteFile(
+			filepath.Join(m.Path(path), "misc.max"),
+			[]byte(fmt.Sprintf("%s %s", resource, v)),
+			defaultFilePerm,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *miscController) Stat(path string, stats *Metrics) error {
+	current, err := readMiscFile(filepath.Join(m.Path(path), "misc.current"))
+	if err != nil {
+		return err
+	}
+	limits, err := readMiscFile(filepath.Join(m.Path(path), "misc.max"))
+	if err != nil {
+		return err
+	}
+	// misc.events is not available on older kernels
+	events, err := readMiscFile(filepath.Join(m.Path(path), "misc.events"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	resources := make([]string, 0, len(current))
+	for resource := range current {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	for _, resource := range resources {
+		stats.Misc = append(stats.Misc, &MiscStat{
+			Resource:  resource,
+			Current:   current[resource],
+			Limit:     limits[resource],
+			MaxEvents: events[resource+".max"],
+		})
+	}
+	return nil
+}
+
+// readMiscFile parses the "<resource> <value>" lines of a misc file, "max"
+// is returned as math.MaxUint64
+func readMiscFile(path string) (map[string]uint64, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]uint64)
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			return nil, ErrInvalidFormat
+		}
+		if parts[1] == "max" {
+			values[parts[0]] = math.MaxUint64
+			continue
+		}
+		v, err := parseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		values[parts[0]] = v
+	}
+	return values, nil
+}
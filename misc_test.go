@@ -0,0 +1,74 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestMisc(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := control.SetMiscLimits(map[string]uint64{"sev": 5}); err != nil {
+		t.Fatal(err)
+	}
+	misc := filepath.Join(mock.root, string(Misc), "test")
+	data, err := ioutil.ReadFile(filepath.Join(misc, "misc.max"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "sev 5" {
+		t.Fatalf("expected %q but received %q", "sev 5", data)
+	}
+	for file, content := range map[string]string{
+		"misc.max":     "sev 5\nsev_es max\n",
+		"misc.current": "sev 2\nsev_es 0\n",
+		"misc.events":  "sev.max 1\nsev_es.max 0\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(misc, file), []byte(content), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var metrics Metrics
+	if err := NewMisc(mock.root).Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	expected := []MiscStat{
+		{Resource: "sev", Current: 2, Limit: 5, MaxEvents: 1},
+		{Resource: "sev_es", Current: 0, Limit: math.MaxUint64},
+	}
+	if len(metrics.Misc) != len(expected) {
+		t.Fatalf("expected %d misc stats but received %d", len(expected), len(metrics.Misc))
+	}
+	for i, s := range metrics.Misc {
+		if *s != expected[i] {
+			t.Errorf("expected %+v but received %+v", expected[i], *s)
+		}
+	}
+}
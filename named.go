@@ -20,7 +20,7 @@ import "path/filepath"
 
 func NewNamed(root string, name Name) *namedController {
 	return &namedController{
-		root: root,
+		root: filepath.Join(root, string(name)),
 		name: name,
 	}
 }
@@ -35,5 +35,5 @@ func (n *namedController) Name() Name {
 }
 
 func (n *namedController) Path(path string) string {
-	return filepath.Join(n.root, string(n.name), path)
+	return filepath.Join(n.root, path)
 }
@@ -16,7 +16,14 @@
 
 package cgroups
 
-import "testing"
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
 
 func TestNamedNameValue(t *testing.T) {
 	n := NewNamed("/sys/fs/cgroup", "systemd")
@@ -32,3 +39,58 @@ func TestNamedPath(t *testing.T) {
 		t.Fatalf("expected %q but received %q from named cgroup", expected, path)
 	}
 }
+
+func TestNamedProcesses(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	control, err := New(mock.hierarchy, StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := control.Add(Process{Pid: 1234}, "systemd"); err != nil {
+		t.Fatal(err)
+	}
+	procs, err := control.Processes("systemd", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(procs) != 1 || procs[0].Pid != 1234 {
+		t.Fatalf("expected pid 1234 in the systemd hierarchy but received %v", procs)
+	}
+	if filepath.Clean(procs[0].Path) != filepath.Join(mock.root, "systemd", "test") {
+		t.Fatalf("unexpected path %q for the systemd hierarchy", procs[0].Path)
+	}
+}
+
+func TestNamedProcessesV1Root(t *testing.T) {
+	root, err := ioutil.TempDir("", "cgroups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	for _, name := range []string{"systemd", "pids"} {
+		if err := os.Mkdir(filepath.Join(root, name), defaultDirPerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	control, err := New(V1Root(root), StaticPath("test"), &specs.LinuxResources{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := control.Add(Process{Pid: 1234}); err != nil {
+		t.Fatal(err)
+	}
+	procs, err := control.Processes("systemd", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(procs) != 1 || procs[0].Pid != 1234 {
+		t.Fatalf("expected pid 1234 in the systemd hierarchy but received %v", procs)
+	}
+	if filepath.Clean(procs[0].Path) != filepath.Join(root, "systemd", "test") {
+		t.Fatalf("unexpected path %q for the systemd hierarchy", procs[0].Path)
+	}
+}
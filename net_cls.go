@@ -17,10 +17,12 @@
 package cgroups
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -56,3 +58,57 @@ func (n *netclsController) Create(path string, resources *specs.LinuxResources)
 	}
 	return nil
 }
+
+func (n *netclsController) Update(path string, resources *specs.LinuxResources) error {
+	return n.Create(path, resources)
+}
+
+func (n *netclsController) Diff(path string, resources *specs.LinuxResources) ([]Change, error) {
+	if resources.Network == nil || resources.Network.ClassID == nil || *resources.Network.ClassID == 0 {
+		return nil, nil
+	}
+	change, err := diffFile(NetCLS, n.Path(path), "net_cls.classid", strconv.FormatUint(uint64(*resources.Network.ClassID), 10))
+	if err != nil || change == nil {
+		return nil, err
+	}
+	return []Change{*change}, nil
+}
+
+// ClassID returns the class id tagged on packets sent from the cgroup
+func (n *netclsController) ClassID(path string) (uint32, error) {
+	v, err := readUint(filepath.Join(n.Path(path), "net_cls.classid"))
+	if err != nil {
+		return 0, err
+	}
+	return uint32(v), nil
+}
+
+// NetClassID composes a net_cls class id from the traffic control major and
+// minor handles, 0x10:0x1 becomes 0x100001
+func NetClassID(major, minor uint16) uint32 {
+	return uint32(major)<<16 | uint32(minor)
+}
+
+// SplitNetClassID returns the traffic control major and minor handles of the
+// net_cls class id
+func SplitNetClassID(classid uint32) (major, minor uint16) {
+	return uint16(classid >> 16), uint16(classid)
+}
+
+// ParseNetClassID parses a class id in the hexadecimal "major:minor" format
+// used by tc
+func ParseNetClassID(s string) (uint32, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid net_cls class id %q", s)
+	}
+	major, err := strconv.ParseUint(parts[0], 16, 16)
+	if err != nil {
+		return 0, err
+	}
+	minor, err := strconv.ParseUint(parts[1], 16, 16)
+	if err != nil {
+		return 0, err
+	}
+	return NetClassID(uint16(major), uint16(minor)), nil
+}
@@ -0,0 +1,71 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestNetClassID(t *testing.T) {
+	classid := NetClassID(0x10, 0x1)
+	if classid != 0x100001 {
+		t.Fatalf("expected class id 0x100001 but received %#x", classid)
+	}
+	major, minor := SplitNetClassID(classid)
+	if major != 0x10 || minor != 0x1 {
+		t.Fatalf("expected 10:1 but received %x:%x", major, minor)
+	}
+	parsed, err := ParseNetClassID("10:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed != classid {
+		t.Fatalf("expected class id %#x but received %#x", classid, parsed)
+	}
+	for _, s := range []string{"10", "10:1:1", "10:x", "10000:1"} {
+		if _, err := ParseNetClassID(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
+
+func TestNetClsUpdate(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	netcls := NewNetCls(mock.root)
+	classid := NetClassID(0x10, 0x1)
+	if err := netcls.Create("test", &specs.LinuxResources{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := netcls.Update("test", &specs.LinuxResources{
+		Network: &specs.LinuxNetwork{ClassID: &classid},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	v, err := netcls.ClassID("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != classid {
+		t.Fatalf("expected class id %#x but received %#x", classid, v)
+	}
+}
@@ -19,8 +19,11 @@ package cgroups
 import (
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -48,6 +51,11 @@ func (n *netprioController) Create(path string, resources *specs.LinuxResources)
 		return err
 	}
 	if resources.Network != nil {
+		for _, prio := range resources.Network.Priorities {
+			if err := checkInterface(prio.Name); err != nil {
+				return err
+			}
+		}
 		for _, prio := range resources.Network.Priorities {
 			if err := ioutil.WriteFile(
 				filepath.Join(n.Path(path), "net_prio.ifpriomap"),
@@ -61,6 +69,90 @@ func (n *netprioController) Create(path string, resources *specs.LinuxResources)
 	return nil
 }
 
+// checkInterface returns an error if the interface does not exist in the
+// current network namespace, the kernel rejects unknown interfaces
+func checkInterface(name string) error {
+	if _, err := net.InterfaceByName(name); err != nil {
+		return fmt.Errorf("net_prio: interface %q: %v", name, err)
+	}
+	return nil
+}
+
 func formatPrio(name string, prio uint32) []byte {
 	return []byte(fmt.Sprintf("%s %d", name, prio))
 }
+
+func (n *netprioController) Update(path string, resources *specs.LinuxResources) error {
+	return n.Create(path, resources)
+}
+
+func (n *netprioController) Diff(path string, resources *specs.LinuxResources) ([]Change, error) {
+	if resources.Network == nil || len(resources.Network.Priorities) == 0 {
+		return nil, nil
+	}
+	current, err := n.Priorities(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	var changes []Change
+	for _, prio := range resources.Network.Priorities {
+		v, ok := current[prio.Name]
+		if ok && v == prio.Priority {
+			continue
+		}
+		change := Change{
+			Subsystem: NetPrio,
+			File:      "net_prio.ifpriomap",
+			Desired:   string(formatPrio(prio.Name, prio.Priority)),
+		}
+		if ok {
+			change.Current = string(formatPrio(prio.Name, v))
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// Priorities returns the priority of each interface from net_prio.ifpriomap
+func (n *netprioController) Priorities(path string) (map[string]uint32, error) {
+	data, err := ioutil.ReadFile(filepath.Join(n.Path(path), "net_prio.ifpriomap"))
+	if err != nil {
+		return nil, err
+	}
+	priorities := make(map[string]uint32)
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			return nil, ErrInvalidFormat
+		}
+		prio, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		priorities[parts[0]] = uint32(prio)
+	}
+	return priorities, nil
+}
+
+// SetPriorities writes the priorities to net_prio.ifpriomap after checking
+// that every interface exists in the current network namespace
+func (n *netprioController) SetPriorities(path string, priorities map[string]uint32) error {
+	for name := range priorities {
+		if err := checkInterface(name); err != nil {
+			return err
+		}
+	}
+	for name, prio := range priorities {
+		if err := ioutil.WriteFile(
+			filepath.Join(n.Path(path), "net_prio.ifpriomap"),
+			formatPrio(name, prio),
+			defaultFilePerm,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
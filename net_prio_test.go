@@ -0,0 +1,100 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestNetPrioPriorities(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	netprio := NewNetPrio(mock.root)
+	if err := netprio.Create("test", &specs.LinuxResources{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := netprio.SetPriorities("test", map[string]uint32{"lo": 5}); err != nil {
+		t.Fatal(err)
+	}
+	if err := netprio.SetPriorities("test", map[string]uint32{"cgroups-missing0": 1}); err == nil {
+		t.Fatal("expected error for a missing interface")
+	}
+	ifpriomap := filepath.Join(netprio.Path("test"), "net_prio.ifpriomap")
+	data, err := ioutil.ReadFile(ifpriomap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "lo 5" {
+		t.Fatalf("expected %q but received %q", "lo 5", data)
+	}
+	if err := ioutil.WriteFile(ifpriomap, []byte("lo 5\neth0 0\n"), defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	priorities, err := netprio.Priorities("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(priorities) != 2 || priorities["lo"] != 5 || priorities["eth0"] != 0 {
+		t.Fatalf("unexpected priorities %v", priorities)
+	}
+	changes, err := netprio.Diff("test", &specs.LinuxResources{
+		Network: &specs.LinuxNetwork{
+			Priorities: []specs.LinuxInterfacePriority{
+				{Name: "lo", Priority: 5},
+				{Name: "eth0", Priority: 2},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Change{Subsystem: NetPrio, File: "net_prio.ifpriomap", Current: "eth0 0", Desired: "eth0 2"}
+	if len(changes) != 1 || changes[0] != expected {
+		t.Fatalf("expected change %+v but received %+v", expected, changes)
+	}
+}
+
+func TestNetPrioCreateMissingInterface(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	netprio := NewNetPrio(mock.root)
+	err = netprio.Create("test", &specs.LinuxResources{
+		Network: &specs.LinuxNetwork{
+			Priorities: []specs.LinuxInterfacePriority{
+				{Name: "lo", Priority: 5},
+				{Name: "cgroups-missing0", Priority: 1},
+			},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected error for a missing interface")
+	}
+	if _, err := ioutil.ReadFile(filepath.Join(netprio.Path("test"), "net_prio.ifpriomap")); !os.IsNotExist(err) {
+		t.Fatalf("expected no priorities to be written but received %v", err)
+	}
+}
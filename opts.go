@@ -17,6 +17,9 @@
 package cgroups
 
 import (
+	"os"
+	"time"
+
 	"github.com/pkg/errors"
 )
 
@@ -36,6 +39,35 @@ type InitOpts func(*InitConfig) error
 type InitConfig struct {
 	// InitCheck can be used to check initialization errors from the subsystem
 	InitCheck InitCheck
+	// SkipUnmounted skips the subsystems of the hierarchy that are not
+	// mounted instead of creating their cgroups, it is off by default
+	SkipUnmounted bool
+	// FreezeTimeout limits how long Freeze and Thaw wait for the freezer
+	// state to settle, zero waits forever
+	FreezeTimeout time.Duration
+	// CpusetCloneChildren sets cgroup.clone_children on the new cpuset cgroup
+	// so its children inherit its cpus and mems
+	CpusetCloneChildren bool
+	// Ownership is applied to the created cgroups when set
+	Ownership *Ownership
+	// VerifyAttach checks /proc/<pid>/cgroup after adding a process
+	VerifyAttach bool
+	// Processes are added to the cgroup once it is created
+	Processes []Process
+	// ExistOK loads the cgroup instead of creating it when it already exists
+	ExistOK bool
+	// Controllers limits the subsystems of the hierarchy that are managed,
+	// all of them are managed when it is empty
+	Controllers []Name
+}
+
+// Ownership is applied to the directories and interface files of created
+// cgroups so that an unprivileged user can manage them
+type Ownership struct {
+	UID int
+	GID int
+	// FileMode is set on the interface files when it is not zero
+	FileMode os.FileMode
 }
 
 func newInitConfig() *InitConfig {
@@ -59,3 +91,113 @@ func RequireDevices(s Subsystem, p Path, err error) error {
 	}
 	return ErrIgnoreSubsystem
 }
+
+// WithSkipUnmounted toggles skipping the subsystems that are not mounted
+// when creating a cgroup, skipped subsystems are reported by Skipped
+func WithSkipUnmounted(skip bool) InitOpts {
+	return func(c *InitConfig) error {
+		c.SkipUnmounted = skip
+		return nil
+	}
+}
+
+// WithFreezeTimeout sets the maximum time Freeze and Thaw wait for the cgroup
+// to reach the requested freezer state
+func WithFreezeTimeout(timeout time.Duration) InitOpts {
+	return func(c *InitConfig) error {
+		c.FreezeTimeout = timeout
+		return nil
+	}
+}
+
+// WithCpusetCloneChildren makes the children of the new cpuset cgroup start
+// with its cpus and mems instead of empty ones
+func WithCpusetCloneChildren() InitOpts {
+	return func(c *InitConfig) error {
+		c.CpusetCloneChildren = true
+		return nil
+	}
+}
+
+// WithOwnership changes the owner of the created cgroup directories and their
+// interface files to uid and gid, and sets their mode if it is not zero, so
+// the cgroups can be delegated to a rootless manager
+func WithOwnership(uid, gid int, mode os.FileMode) InitOpts {
+	return func(c *InitConfig) error {
+		c.Ownership = &Ownership{
+			UID:      uid,
+			GID:      gid,
+			FileMode: mode,
+		}
+		return nil
+	}
+}
+
+// WithAttachVerification makes Add check that /proc/<pid>/cgroup lists the
+// cgroup after the process was added, returning ErrProcessMoved if the
+// process was moved concurrently and ErrProcessNotExist if it exited
+func WithAttachVerification() InitOpts {
+	return func(c *InitConfig) error {
+		c.VerifyAttach = true
+		return nil
+	}
+}
+
+// WithProcesses adds the processes, such as the caller and its children, to
+// the cgroup once it is created. Every thread of a process is moved at once,
+// but the move is not atomic across subsystems. If a process cannot be added
+// the processes already moved are moved back and a newly created cgroup is
+// removed.
+func WithProcesses(processes ...Process) InitOpts {
+	return func(c *InitConfig) error {
+		c.Processes = append(c.Processes, processes...)
+		return nil
+	}
+}
+
+// WithExistOK makes New load the cgroup when it already exists in every
+// mounted subsystem instead of creating it, the resources are then applied
+// with Update. ErrCgroupIncomplete is returned when it only exists in some of
+// them and ErrInvalidOwnership when it is not owned by WithOwnership's user.
+func WithExistOK() InitOpts {
+	return func(c *InitConfig) error {
+		c.ExistOK = true
+		return nil
+	}
+}
+
+// WithControllers only creates and manages the cgroup in the listed
+// subsystems of the hierarchy, leaving the others untouched for the agents
+// that own them. ErrNoSuchSubsystem is returned for a name that is not in the
+// hierarchy.
+func WithControllers(names ...Name) InitOpts {
+	return func(c *InitConfig) error {
+		c.Controllers = append(c.Controllers, names...)
+		return nil
+	}
+}
+
+// DeleteOpts allows configuration for the deletion of a cgroup
+type DeleteOpts func(*DeleteConfig) error
+
+// DeleteConfig provides configuration options for the deletion of a cgroup
+type DeleteConfig struct {
+	// Kill kills the processes remaining in the cgroup before removing it
+	Kill bool
+	// MoveToParent moves the processes remaining in the cgroup to its parent
+	// before removing it
+	MoveToParent bool
+}
+
+// KillProcesses kills the processes remaining in the cgroup before it is removed
+func KillProcesses(c *DeleteConfig) error {
+	c.Kill = true
+	return nil
+}
+
+// MoveProcessesToParent moves the processes remaining in the cgroup to the
+// parent cgroup before it is removed
+func MoveProcessesToParent(c *DeleteConfig) error {
+	c.MoveToParent = true
+	return nil
+}
@@ -19,6 +19,7 @@ package cgroups
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -31,6 +32,10 @@ func RootPath(subsysem Name) (string, error) {
 
 // StaticPath returns a static path to use for all cgroups
 func StaticPath(path string) Path {
+	path, err := sanitizePath(path)
+	if err != nil {
+		return errorPath(err)
+	}
 	return func(_ Name) (string, error) {
 		return path, nil
 	}
@@ -76,6 +81,16 @@ func existingPath(paths map[string]string, suffix string) Path {
 		}
 		paths[n] = filepath.Join("/", rel)
 	}
+	return lookupPath(paths, suffix)
+}
+
+// lookupPath returns the path of each subsystem from the parsed cgroup paths.
+// Named hierarchies, such as systemd, are keyed as "name=systemd".
+func lookupPath(paths map[string]string, suffix string) Path {
+	suffix, err := sanitizePath(suffix)
+	if err != nil {
+		return errorPath(err)
+	}
 	return func(name Name) (string, error) {
 		root, ok := paths[string(name)]
 		if !ok {
@@ -91,6 +106,10 @@ func existingPath(paths map[string]string, suffix string) Path {
 }
 
 func subPath(path Path, subName string) Path {
+	subName, err := sanitizePath(subName)
+	if err != nil {
+		return errorPath(err)
+	}
 	return func(name Name) (string, error) {
 		p, err := path(name)
 		if err != nil {
@@ -105,3 +124,21 @@ func errorPath(err error) Path {
 		return "", err
 	}
 }
+
+// sanitizePath cleans a user supplied cgroup path and returns ErrInvalidPath
+// if it contains NUL bytes or ".." elements, which could otherwise be used to
+// reach files outside of the cgroup mount
+func sanitizePath(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	if strings.ContainsRune(path, 0) {
+		return "", ErrInvalidPath
+	}
+	for _, e := range strings.Split(filepath.ToSlash(path), "/") {
+		if e == ".." {
+			return "", ErrInvalidPath
+		}
+	}
+	return filepath.Clean(path), nil
+}
@@ -34,6 +34,28 @@ func TestStaticPath(t *testing.T) {
 	}
 }
 
+func TestSanitizePath(t *testing.T) {
+	for _, path := range []Path{
+		StaticPath("../escape"),
+		StaticPath("/test/../../escape"),
+		StaticPath("test\x00"),
+		subPath(StaticPath("test"), ".."),
+		Slice("system.slice", "../escape"),
+		lookupPath(map[string]string{"devices": "/"}, "../escape"),
+	} {
+		if _, err := path(Devices); err != ErrInvalidPath {
+			t.Errorf("expected error %q but received %v", ErrInvalidPath, err)
+		}
+	}
+	p, err := StaticPath("/test//child/./")("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != "/test/child" {
+		t.Fatalf("expected cleaned path %q but received %q", "/test/child", p)
+	}
+}
+
 func TestSelfPath(t *testing.T) {
 	_, err := v1MountPoint()
 	if err == ErrMountPointNotExist {
@@ -138,3 +160,49 @@ func TestSystemd240(t *testing.T) {
 		t.Fatalf("expected error %q but received %q", ErrControllerNotActive, err)
 	}
 }
+
+func TestNamedHierarchyPath(t *testing.T) {
+	const data = `2:cpu,cpuacct:/system.slice/docker.service
+	1:name=systemd:/system.slice/docker.service`
+	r := strings.NewReader(data)
+	paths, err := parseCgroupFromReader(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := lookupPath(paths, "child")
+	p, err := path("systemd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "/system.slice/docker.service/child"; p != expected {
+		t.Fatalf("expected systemd path %q but received %q", expected, p)
+	}
+	if _, err := path("elogind"); err != ErrControllerNotActive {
+		t.Fatalf("expected error %q but received %q", ErrControllerNotActive, err)
+	}
+}
+
+func TestVerifyMembership(t *testing.T) {
+	const data = `4:memory:/test
+	3:pids:/other
+	2:cpu,cpuacct:/test
+	1:name=systemd:/test`
+	paths, err := parseCgroupFromReader(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyMembership(paths, map[Name]string{
+		Memory:    "test",
+		Cpuacct:   "/test",
+		"systemd": "test",
+		Rdma:      "test",
+	}); err != nil {
+		t.Fatalf("expected membership to be verified but received %v", err)
+	}
+	if err := verifyMembership(paths, map[Name]string{
+		Memory: "test",
+		Pids:   "test",
+	}); err != ErrProcessMoved {
+		t.Fatalf("expected ErrProcessMoved but received %v", err)
+	}
+}
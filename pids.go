@@ -22,8 +22,10 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"unsafe"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
 )
 
 func NewPids(root string) *pidsController {
@@ -62,6 +64,18 @@ func (p *pidsController) Update(path string, resources *specs.LinuxResources) er
 	return p.Create(path, resources)
 }
 
+// Diff returns the pids limit if it differs from the cgroup
+func (p *pidsController) Diff(path string, resources *specs.LinuxResources) ([]Change, error) {
+	if resources.Pids == nil || resources.Pids.Limit <= 0 {
+		return nil, nil
+	}
+	change, err := diffFile(Pids, p.Path(path), "pids.max", strconv.FormatInt(resources.Pids.Limit, 10))
+	if err != nil || change == nil {
+		return nil, err
+	}
+	return []Change{*change}, nil
+}
+
 func (p *pidsController) Stat(path string, stats *Metrics) error {
 	current, err := readUint(filepath.Join(p.Path(path), "pids.current"))
 	if err != nil {
@@ -77,9 +91,98 @@ func (p *pidsController) Stat(path string, stats *Metrics) error {
 			return err
 		}
 	}
+	maxEvents, err := readPidsMaxEvents(filepath.Join(p.Path(path), "pids.events"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
 	stats.Pids = &PidsStat{
-		Current: current,
-		Limit:   max,
+		Current:   current,
+		Limit:     max,
+		MaxEvents: maxEvents,
 	}
 	return nil
 }
+
+// RegisterMaxEvent returns a channel that receives the number of times forks
+// failed because pids.max was reached, each time the counter increases. This
+// can be used to detect fork bombs inside the cgroup. The channel is closed
+// once the cgroup is removed.
+func (p *pidsController) RegisterMaxEvent(path string) (<-chan uint64, error) {
+	events := filepath.Join(p.Path(path), "pids.events")
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := unix.InotifyAddWatch(fd, events, unix.IN_MODIFY); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	ch := make(chan uint64, 1)
+	go func() {
+		defer func() {
+			unix.Close(fd)
+			close(ch)
+		}()
+		buf := make([]byte, unix.SizeofInotifyEvent*16)
+		for {
+			n, err := unix.Read(fd, buf)
+			if err != nil {
+				if err == unix.EINTR {
+					continue
+				}
+				return
+			}
+			var modified bool
+			for offset := 0; offset+unix.SizeofInotifyEvent <= n; {
+				ev := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+				// the watch is removed along with the cgroup
+				if ev.Mask&unix.IN_IGNORED != 0 {
+					return
+				}
+				if ev.Mask&unix.IN_MODIFY != 0 {
+					modified = true
+				}
+				offset += unix.SizeofInotifyEvent + int(ev.Len)
+			}
+			if !modified {
+				continue
+			}
+			v, err := readPidsMaxEvents(events)
+			if err != nil {
+				return
+			}
+			select {
+			case ch <- v:
+			default:
+				// replace the stale value that has not been received yet
+				select {
+				case <-ch:
+				default:
+				}
+				ch <- v
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// readPidsMaxEvents returns the "max" counter of the pids.events file
+func readPidsMaxEvents(path string) (uint64, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" {
+			continue
+		}
+		key, v, err := parseKV(line)
+		if err != nil {
+			return 0, err
+		}
+		if key == "max" {
+			return v, nil
+		}
+	}
+	return 0, nil
+}
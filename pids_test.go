@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -170,3 +171,47 @@ func TestPidsOverflowMax(t *testing.T) {
 		t.Fatal("expected not nil err")
 	}
 }
+
+func TestPidsMaxEvents(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	pids := NewPids(mock.root)
+	if err := pids.Create("test", &specs.LinuxResources{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range []string{"pids.current", "pids.max"} {
+		if err := ioutil.WriteFile(filepath.Join(mock.root, "pids", "test", f), []byte("0"), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events := filepath.Join(mock.root, "pids", "test", "pids.events")
+	if err := ioutil.WriteFile(events, []byte("max 0\n"), defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	ch, err := pids.RegisterMaxEvent("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(events, []byte("max 3\n"), defaultFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	timeout := time.After(5 * time.Second)
+	// the truncate of the write may be reported before the new content
+	for v := uint64(0); v != 3; {
+		select {
+		case v = <-ch:
+		case <-timeout:
+			t.Fatal("timed out waiting for pids.events notification")
+		}
+	}
+	var metrics Metrics
+	if err := pids.Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	if metrics.Pids.MaxEvents != 3 {
+		t.Fatalf("expected max events 3 but received %d", metrics.Pids.MaxEvents)
+	}
+}
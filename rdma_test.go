@@ -0,0 +1,73 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+func TestRdmaStat(t *testing.T) {
+	mock, err := newMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mock.delete()
+	rdma := NewRdma(mock.root)
+	handles := uint32(10)
+	if err := rdma.Create("test", &specs.LinuxResources{
+		Rdma: map[string]specs.LinuxRdma{
+			"mlx4_0": {HcaHandles: &handles},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(rdma.Path("test"), "rdma.max"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "mlx4_0 hca_handle=10" {
+		t.Fatalf("unexpected rdma.max %q", data)
+	}
+	for file, content := range map[string]string{
+		"rdma.max":     "mlx4_0 hca_handle=10 hca_object=max\nocrdma1 hca_handle=3 hca_object=max\n",
+		"rdma.current": "mlx4_0 hca_handle=2 hca_object=20\nocrdma1 hca_handle=0 hca_object=0\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(rdma.Path("test"), file), []byte(content), defaultFilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var metrics Metrics
+	if err := rdma.Stat("test", &metrics); err != nil {
+		t.Fatal(err)
+	}
+	if l := len(metrics.Rdma.Limit); l != 2 {
+		t.Fatalf("expected 2 rdma limits but received %d", l)
+	}
+	limit := metrics.Rdma.Limit[0]
+	if limit.Device != "mlx4_0" || limit.HcaHandles != 10 || limit.HcaObjects != math.MaxUint32 {
+		t.Errorf("unexpected rdma limit %+v", limit)
+	}
+	current := metrics.Rdma.Current[0]
+	if current.Device != "mlx4_0" || current.HcaHandles != 2 || current.HcaObjects != 20 {
+		t.Errorf("unexpected rdma usage %+v", current)
+	}
+}
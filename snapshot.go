@@ -0,0 +1,188 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// Snapshot holds the values of the tunable files of a cgroup, it can be
+// serialized for support bundles or checkpoint and restore tooling
+type Snapshot struct {
+	// Files maps each subsystem to the content of its tunable files
+	// keyed by file name, such as "memory.limit_in_bytes"
+	Files map[Name]map[string]string `json:"files"`
+}
+
+// snapshotExcluded are read-write files that are not tunables, writing them
+// adds processes, registers events, resets counters or changes the state of
+// the group
+var snapshotExcluded = map[string]bool{
+	cgroupProcs:             true,
+	cgroupTasks:             true,
+	"cgroup.event_control":  true,
+	"memory.force_empty":    true,
+	"memory.pressure_level": true,
+	"blkio.reset_stats":     true,
+	"devices.allow":         true,
+	"devices.deny":          true,
+	"cpuacct.usage":         true,
+	"freezer.state":         true,
+}
+
+// isTunable returns true if the file is a readable and writable setting
+func isTunable(info os.FileInfo) bool {
+	if !info.Mode().IsRegular() || info.Mode().Perm()&0600 != 0600 {
+		return false
+	}
+	name := info.Name()
+	if snapshotExcluded[name] {
+		return false
+	}
+	// counters such as memory.failcnt are reset when written
+	return !strings.HasSuffix(name, "max_usage_in_bytes") && !strings.HasSuffix(name, "failcnt")
+}
+
+// readTunables returns the content of the tunable files in the directory
+func readTunables(dir string) (map[string]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]string)
+	for _, info := range infos {
+		// the device rules are read-only, they are restored through
+		// devices.deny and devices.allow
+		if !isTunable(info) && (info.Name() != listDeviceFile || !info.Mode().IsRegular()) {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, info.Name()))
+		if err != nil {
+			// some settings, such as the rt scheduler files on kernels
+			// without CONFIG_RT_GROUP_SCHED, cannot be read
+			if pathErr, ok := err.(*os.PathError); ok && (pathErr.Err == syscall.EINVAL || pathErr.Err == syscall.EOPNOTSUPP) {
+				continue
+			}
+			return nil, err
+		}
+		files[info.Name()] = strings.TrimSpace(string(data))
+	}
+	return files, nil
+}
+
+// restorePriority orders the files that other settings depend on first, the
+// cpus and mems must be set before tasks can join a cpuset cgroup and the
+// periods and memory limits bound the quotas and memsw limits
+var restorePriority = map[string]int{
+	"cpuset.cpus":                -2,
+	"cpuset.mems":                -2,
+	"cpu.cfs_period_us":          -1,
+	"cpu.rt_period_us":           -1,
+	"memory.limit_in_bytes":      -1,
+	"memory.kmem.limit_in_bytes": -1,
+}
+
+// restoreOrder returns the file names in the order they should be written
+func restoreOrder(files map[string]string) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sort.SliceStable(names, func(i, j int) bool {
+		return restorePriority[names[i]] < restorePriority[names[j]]
+	})
+	return names
+}
+
+// restoreValues returns the values to write to the file to restore the
+// content read by Snapshot. Files listing one setting per line, such as
+// blkio.throttle.read_bps_device, are written a line at a time.
+func restoreValues(name, content string) []string {
+	if name == "memory.oom_control" {
+		for _, line := range strings.Split(content, "\n") {
+			if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "oom_kill_disable" {
+				return []string{fields[1]}
+			}
+		}
+		return nil
+	}
+	var values []string
+	for _, line := range strings.Split(content, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			values = append(values, line)
+		}
+	}
+	return values
+}
+
+// writeTunables writes the files captured by Snapshot to the directory.
+// Files that are missing or already hold the value are skipped and files
+// that fail are retried once after the others, as the memory and memsw
+// limits depend on each other in both directions.
+func writeTunables(dir string, files map[string]string) error {
+	var failed []string
+	for _, pass := range []int{0, 1} {
+		names := failed
+		if pass == 0 {
+			names = restoreOrder(files)
+		}
+		failed = nil
+		for _, name := range names {
+			p := filepath.Join(dir, name)
+			current, err := ioutil.ReadFile(p)
+			if err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+			} else if strings.TrimSpace(string(current)) == files[name] {
+				continue
+			}
+			if err = writeTunable(dir, name, files[name]); err != nil {
+				if pass == 1 {
+					return err
+				}
+				failed = append(failed, name)
+			}
+		}
+	}
+	return nil
+}
+
+// writeTunable writes the content captured by Snapshot to the file. The device
+// rules of devices.list are replaced by denying every device and allowing the
+// listed rules one at a time.
+func writeTunable(dir, name, content string) error {
+	p := filepath.Join(dir, name)
+	if name == listDeviceFile {
+		if err := ioutil.WriteFile(filepath.Join(dir, denyDeviceFile), []byte("a *:* rwm"), defaultFilePerm); err != nil {
+			return err
+		}
+		p = filepath.Join(dir, allowDeviceFile)
+	}
+	for _, v := range restoreValues(name, content) {
+		if err := ioutil.WriteFile(p, []byte(v), defaultFilePerm); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -17,8 +17,6 @@
 package cgroups
 
 import (
-	"fmt"
-
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
@@ -39,6 +37,7 @@ const (
 	Memory    Name = "memory"
 	Blkio     Name = "blkio"
 	Rdma      Name = "rdma"
+	Misc      Name = "misc"
 )
 
 // Subsystems returns a complete list of the default cgroups
@@ -57,6 +56,7 @@ func Subsystems() []Name {
 		Memory,
 		Blkio,
 		Rdma,
+		Misc,
 	}
 	if !isUserNS {
 		n = append(n, Devices)
@@ -64,10 +64,30 @@ func Subsystems() []Name {
 	return n
 }
 
+// Subsystem is a cgroup controller managed by this package. Besides Name a
+// subsystem may implement any of the methods of Controller, which are used
+// when the cgroup is created, updated, stated or deleted.
 type Subsystem interface {
 	Name() Name
 }
 
+// Controller is a subsystem implementing every optional method. Custom
+// controllers for out-of-tree or vendor specific cgroup files can be added to
+// a hierarchy with AddSubsystems.
+type Controller interface {
+	Subsystem
+	// Path returns the directory of the cgroup path for the controller
+	Path(path string) string
+	// Create creates the cgroup and applies the resources
+	Create(path string, resources *specs.LinuxResources) error
+	// Update applies the resources to an existing cgroup
+	Update(path string, resources *specs.LinuxResources) error
+	// Stat adds the controller's metrics to stats
+	Stat(path string, stats *Metrics) error
+	// Delete removes the cgroup, it replaces the removal of Path
+	Delete(path string) error
+}
+
 type pather interface {
 	Subsystem
 	Path(path string) string
@@ -93,6 +113,11 @@ type updater interface {
 	Update(path string, resources *specs.LinuxResources) error
 }
 
+type differ interface {
+	Subsystem
+	Diff(path string, resources *specs.LinuxResources) ([]Change, error)
+}
+
 // SingleSubsystem returns a single cgroup subsystem within the base Hierarchy
 func SingleSubsystem(baseHierarchy Hierarchy, subsystem Name) Hierarchy {
 	return func() ([]Subsystem, error) {
@@ -107,6 +132,18 @@ func SingleSubsystem(baseHierarchy Hierarchy, subsystem Name) Hierarchy {
 				}, nil
 			}
 		}
-		return nil, fmt.Errorf("unable to find subsystem %s", subsystem)
+		return nil, ErrNoSuchSubsystem
+	}
+}
+
+// AddSubsystems returns the subsystems of the base Hierarchy along with the
+// provided subsystems, such as custom controllers
+func AddSubsystems(baseHierarchy Hierarchy, subsystems ...Subsystem) Hierarchy {
+	return func() ([]Subsystem, error) {
+		base, err := baseHierarchy()
+		if err != nil {
+			return nil, err
+		}
+		return append(base, subsystems...), nil
 	}
 }
@@ -58,11 +58,65 @@ func Slice(slice, name string) Path {
 	if slice == "" {
 		slice = defaultSlice
 	}
+	for _, p := range []*string{&slice, &name} {
+		v, err := sanitizePath(*p)
+		if err != nil {
+			return errorPath(err)
+		}
+		*p = v
+	}
 	return func(subsystem Name) (string, error) {
 		return filepath.Join(slice, name), nil
 	}
 }
 
+// ExpandSlice returns the cgroup path of a systemd slice, each dash in the
+// name adds a parent slice so "a-b-c.slice" is placed in
+// "/a.slice/a-b.slice/a-b-c.slice". The root slice "-.slice" expands to "/".
+func ExpandSlice(slice string) (string, error) {
+	const suffix = ".slice"
+	if len(slice) <= len(suffix) || !strings.HasSuffix(slice, suffix) || strings.Contains(slice, "/") {
+		return "", ErrInvalidSlice
+	}
+	if slice == "-.slice" {
+		return "/", nil
+	}
+	name := strings.TrimSuffix(slice, suffix)
+	var (
+		path   string
+		prefix string
+	)
+	for _, component := range strings.Split(name, "-") {
+		// empty components come from leading, trailing or repeated dashes
+		if component == "" {
+			return "", ErrInvalidSlice
+		}
+		path = filepath.Join(path, prefix+component+suffix)
+		prefix += component + "-"
+	}
+	return filepath.Join("/", path), nil
+}
+
+// ScopePath returns the cgroup path of the "<prefix>-<name>.scope" unit
+// placed inside the expanded slice, the default slice is used if it is empty
+func ScopePath(slice, prefix, name string) (string, error) {
+	if slice == "" {
+		slice = defaultSlice
+	}
+	path, err := ExpandSlice(slice)
+	if err != nil {
+		return "", err
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return "", ErrInvalidPath
+	}
+	unit := name + ".scope"
+	if prefix != "" {
+		unit = prefix + "-" + unit
+	}
+	return filepath.Join(path, unit), nil
+}
+
 func NewSystemd(root string) (*SystemdController, error) {
 	return &SystemdController{
 		root: root,
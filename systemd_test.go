@@ -0,0 +1,67 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import "testing"
+
+func TestExpandSlice(t *testing.T) {
+	for _, tt := range []struct {
+		slice    string
+		expected string
+		err      error
+	}{
+		{slice: "-.slice", expected: "/"},
+		{slice: "system.slice", expected: "/system.slice"},
+		{slice: "a-b-c.slice", expected: "/a.slice/a-b.slice/a-b-c.slice"},
+		{slice: "user-1000.slice", expected: "/user.slice/user-1000.slice"},
+		{slice: "system", err: ErrInvalidSlice},
+		{slice: ".slice", err: ErrInvalidSlice},
+		{slice: "a--b.slice", err: ErrInvalidSlice},
+		{slice: "-a.slice", err: ErrInvalidSlice},
+		{slice: "a-.slice", err: ErrInvalidSlice},
+		{slice: "a/b.slice", err: ErrInvalidSlice},
+	} {
+		p, err := ExpandSlice(tt.slice)
+		if err != tt.err {
+			t.Errorf("expected error %v for %q but received %v", tt.err, tt.slice, err)
+			continue
+		}
+		if p != tt.expected {
+			t.Errorf("expected path %q for %q but received %q", tt.expected, tt.slice, p)
+		}
+	}
+}
+
+func TestScopePath(t *testing.T) {
+	p, err := ScopePath("machine-qemu.slice", "libpod", "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "/machine.slice/machine-qemu.slice/libpod-abc.scope"; p != expected {
+		t.Fatalf("expected scope path %q but received %q", expected, p)
+	}
+	p, err = ScopePath("", "", "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "/system.slice/abc.scope"; p != expected {
+		t.Fatalf("expected scope path %q but received %q", expected, p)
+	}
+	if _, err := ScopePath("", "", "../abc"); err != ErrInvalidPath {
+		t.Fatalf("expected error %q but received %v", ErrInvalidPath, err)
+	}
+}
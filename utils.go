@@ -18,6 +18,7 @@ package cgroups
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -25,10 +26,12 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	units "github.com/docker/go-units"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
 )
 
 var isUserNS = runningInUserNS()
@@ -62,6 +65,32 @@ func runningInUserNS() bool {
 	return true
 }
 
+var kmemDeprecated = kernelMemoryDeprecated()
+
+// kernelMemoryDeprecated returns true if the running kernel is 5.4 or newer
+// where kernel memory is always accounted in the memory limit and setting
+// memory.kmem.limit_in_bytes is deprecated
+func kernelMemoryDeprecated() bool {
+	var uts unix.Utsname
+	if err := unix.Uname(&uts); err != nil {
+		return false
+	}
+	major, minor, err := parseKernelVersion(strings.TrimRight(string(uts.Release[:]), "\x00"))
+	if err != nil {
+		return false
+	}
+	return major > 5 || (major == 5 && minor >= 4)
+}
+
+// parseKernelVersion returns the major and minor version of a kernel
+// release string such as "5.4.0-42-generic"
+func parseKernelVersion(release string) (major, minor int, err error) {
+	if _, err := fmt.Sscanf(release, "%d.%d", &major, &minor); err != nil {
+		return 0, 0, fmt.Errorf("invalid kernel release %q: %v", release, err)
+	}
+	return major, minor, nil
+}
+
 // defaults returns all known groups
 func defaults(root string) ([]Subsystem, error) {
 	h, err := NewHugetlb(root)
@@ -81,6 +110,7 @@ func defaults(root string) ([]Subsystem, error) {
 		NewMemory(root),
 		NewBlkio(root),
 		NewRdma(root),
+		NewMisc(root),
 	}
 	// only add the devices cgroup if we are not in a user namespace
 	// because modifications are not allowed
@@ -95,9 +125,118 @@ func defaults(root string) ([]Subsystem, error) {
 	return s, nil
 }
 
-// remove will remove a cgroup path handling EAGAIN and EBUSY errors and
-// retrying the remove after a exp timeout
+// chownSubsystems applies the ownership to the cgroup directory of each
+// subsystem and the interface files inside it
+func chownSubsystems(subsystems []Subsystem, path Path, ownership *Ownership) error {
+	if ownership == nil {
+		return nil
+	}
+	for _, s := range pathers(subsystems) {
+		p, err := path(s.Name())
+		if err != nil {
+			if err == ErrControllerNotActive {
+				continue
+			}
+			return err
+		}
+		dir := s.Path(p)
+		if err := os.Chown(dir, ownership.UID, ownership.GID); err != nil {
+			return err
+		}
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			if f.IsDir() {
+				continue
+			}
+			name := filepath.Join(dir, f.Name())
+			if err := os.Chown(name, ownership.UID, ownership.GID); err != nil {
+				return err
+			}
+			if ownership.FileMode != 0 {
+				if err := os.Chmod(name, ownership.FileMode); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// resourcesKey returns the encoded part of the resources that is written by
+// the subsystem, or nil if it is unknown
+func resourcesKey(name Name, resources *specs.LinuxResources) []byte {
+	if resources == nil {
+		return nil
+	}
+	var v interface{}
+	switch name {
+	case Cpu, Cpuset:
+		v = resources.CPU
+	case Memory:
+		v = resources.Memory
+	case Blkio:
+		v = resources.BlockIO
+	case Pids:
+		v = resources.Pids
+	case Hugetlb:
+		v = resources.HugepageLimits
+	case Devices:
+		v = resources.Devices
+	case NetCLS, NetPrio:
+		v = resources.Network
+	case Rdma:
+		v = resources.Rdma
+	default:
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// appliedResources returns the encoded resources written to the subsystems
+func appliedResources(subsystems []Subsystem, resources *specs.LinuxResources) map[Name][]byte {
+	applied := make(map[Name][]byte)
+	for _, s := range subsystems {
+		if key := resourcesKey(s.Name(), resources); key != nil {
+			applied[s.Name()] = key
+		}
+	}
+	return applied
+}
+
+// remove will remove a cgroup path and its children, deepest first, handling
+// EAGAIN and EBUSY errors and retrying the remove after a exp timeout
 func remove(path string) error {
+	var dirs []string
+	if err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			dirs = append(dirs, p)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := removeDir(dirs[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func removeDir(path string) error {
 	delay := 10 * time.Millisecond
 	for i := 0; i < 5; i++ {
 		if i != 0 {
@@ -111,6 +250,59 @@ func remove(path string) error {
 	return fmt.Errorf("cgroups: unable to remove path %q", path)
 }
 
+// writePid writes the pid to a cgroup.procs or tasks file, retrying when the
+// write is interrupted or the process is not yet visible while it starts up.
+// ErrProcessNotExist is returned if the process never became visible.
+func writePid(path string, pid int) error {
+	var err error
+	for i := 0; i < 5; i++ {
+		if i != 0 {
+			time.Sleep(time.Duration(i) * time.Millisecond)
+		}
+		if err = ioutil.WriteFile(
+			path,
+			[]byte(strconv.Itoa(pid)),
+			defaultFilePerm,
+		); err == nil {
+			return nil
+		}
+		if perr, ok := err.(*os.PathError); !ok || (perr.Err != syscall.EINTR && perr.Err != syscall.ESRCH) {
+			return err
+		}
+	}
+	if perr, ok := err.(*os.PathError); ok && perr.Err == syscall.ESRCH {
+		return ErrProcessNotExist
+	}
+	return err
+}
+
+// verifyMembership compares the paths parsed from /proc/<pid>/cgroup with
+// the expected path of each subsystem. Subsystems that are not listed for the
+// process are not checked.
+func verifyMembership(paths map[string]string, expected map[Name]string) error {
+	for name, p := range expected {
+		actual, ok := paths[string(name)]
+		if !ok {
+			if actual, ok = paths[fmt.Sprintf("name=%s", name)]; !ok {
+				continue
+			}
+		}
+		if filepath.Clean(actual) != filepath.Join("/", p) {
+			return ErrProcessMoved
+		}
+	}
+	return nil
+}
+
+func containsName(names []Name, name Name) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
 // readPids will read all the pids of processes in a cgroup by the provided path
 func readPids(path string, subsystem Name) ([]Process, error) {
 	f, err := os.Open(filepath.Join(path, cgroupProcs))
@@ -290,6 +482,82 @@ func pathers(subystems []Subsystem) []pather {
 	return out
 }
 
+// filterSubsystems returns the subsystems with one of the names, or all of
+// them if no names are provided. ErrNoSuchSubsystem is returned for a name
+// that is not in the hierarchy.
+func filterSubsystems(subsystems []Subsystem, names []Name) ([]Subsystem, error) {
+	if len(names) == 0 {
+		return subsystems, nil
+	}
+	var (
+		out   []Subsystem
+		found []Name
+	)
+	for _, s := range subsystems {
+		if containsName(names, s.Name()) {
+			out = append(out, s)
+			found = append(found, s.Name())
+		}
+	}
+	for _, name := range names {
+		if !containsName(found, name) {
+			return nil, ErrNoSuchSubsystem
+		}
+	}
+	return out, nil
+}
+
+// isMounted returns false if the root of the subsystem does not exist
+func isMounted(s Subsystem) bool {
+	p, ok := s.(pather)
+	if !ok {
+		return true
+	}
+	_, err := os.Lstat(p.Path("/"))
+	return !os.IsNotExist(err)
+}
+
+// existingSubsystems returns the subsystems the cgroup exists in and
+// ErrCgroupIncomplete if it only exists in some of the mounted ones. When
+// ownership is set the existing directories must be owned by its user and
+// group.
+func existingSubsystems(subsystems []Subsystem, path Path, ownership *Ownership) ([]Name, error) {
+	var (
+		found   []Name
+		missing int
+	)
+	for _, s := range pathers(subsystems) {
+		if !isMounted(s) {
+			continue
+		}
+		p, err := path(s.Name())
+		if err != nil {
+			if err == ErrControllerNotActive {
+				continue
+			}
+			return nil, err
+		}
+		info, err := os.Lstat(s.Path(p))
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return nil, err
+			}
+			missing++
+			continue
+		}
+		if ownership != nil {
+			if st, ok := info.Sys().(*syscall.Stat_t); ok && (int(st.Uid) != ownership.UID || int(st.Gid) != ownership.GID) {
+				return nil, ErrInvalidOwnership
+			}
+		}
+		found = append(found, s.Name())
+	}
+	if len(found) > 0 && missing > 0 {
+		return nil, ErrCgroupIncomplete
+	}
+	return found, nil
+}
+
 func initializeSubsystem(s Subsystem, path Path, resources *specs.LinuxResources) error {
 	if c, ok := s.(creator); ok {
 		p, err := path(s.Name())
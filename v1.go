@@ -19,29 +19,189 @@ package cgroups
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
 // V1 returns all the groups in the default cgroups mountpoint in a single hierarchy
 func V1() ([]Subsystem, error) {
-	root, err := v1MountPoint()
+	mounts, err := Mountpoints()
 	if err != nil {
 		return nil, err
 	}
-	subsystems, err := defaults(root)
+	if len(mounts) == 0 {
+		return nil, ErrMountPointNotExist
+	}
+	return mountedSubsystems(mounts)
+}
+
+// V1Root returns the groups mounted below root instead of the mountpoints
+// listed in /proc/self/mountinfo, for systems where the cgroup filesystems are
+// not mounted at /sys/fs/cgroup such as an initramfs or a test sandbox. Each
+// subsystem is expected at root/<name>.
+func V1Root(root string) Hierarchy {
+	return func() ([]Subsystem, error) {
+		mounts := make(map[Name]string)
+		for _, name := range append(Subsystems(), Devices, "systemd") {
+			p := filepath.Join(root, string(name))
+			if _, err := os.Stat(p); err == nil {
+				mounts[name] = p
+			}
+		}
+		if len(mounts) == 0 {
+			return nil, ErrMountPointNotExist
+		}
+		return mountedSubsystems(mounts)
+	}
+}
+
+// Mountpoints returns where each cgroup v1 subsystem is mounted as listed in
+// /proc/self/mountinfo. Comounted subsystems, such as cpu,cpuacct, share the
+// same mountpoint and named hierarchies are keyed by their name.
+func Mountpoints() (map[Name]string, error) {
+	f, err := os.Open("/proc/self/mountinfo")
 	if err != nil {
 		return nil, err
 	}
-	var enabled []Subsystem
-	for _, s := range pathers(subsystems) {
-		// check and remove the default groups that do not exist
-		if _, err := os.Lstat(s.Path("/")); err == nil {
-			enabled = append(enabled, s)
+	defer f.Close()
+	return parseMountinfo(f)
+}
+
+func parseMountinfo(r io.Reader) (map[Name]string, error) {
+	known := make(map[Name]bool)
+	for _, n := range append(Subsystems(), Devices) {
+		known[n] = true
+	}
+	var (
+		mounts  = make(map[Name]string)
+		scanner = bufio.NewScanner(r)
+	)
+	for scanner.Scan() {
+		var (
+			text   = scanner.Text()
+			fields = strings.Split(text, " ")
+			index  = strings.Index(text, " - ")
+		)
+		if index < 0 || len(fields) < 5 {
+			continue
+		}
+		// the super options are the last field after the separator
+		postSeparatorFields := strings.Fields(text[index+3:])
+		if len(postSeparatorFields) < 3 || postSeparatorFields[0] != "cgroup" {
+			continue
+		}
+		for _, opt := range strings.Split(postSeparatorFields[2], ",") {
+			name := Name(strings.TrimPrefix(opt, "name="))
+			if !strings.HasPrefix(opt, "name=") && !known[name] {
+				continue
+			}
+			// keep the first mount when a hierarchy is mounted more than once
+			if _, ok := mounts[name]; !ok {
+				mounts[name] = fields[4]
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return mounts, nil
+}
+
+// mountedSubsystems returns the subsystems rooted at their mountpoints in the
+// same order as the default groups. Named hierarchies, such as systemd, are
+// included so that processes are also tracked in them.
+func mountedSubsystems(mounts map[Name]string) ([]Subsystem, error) {
+	order := []Name{
+		"systemd",
+		Freezer,
+		Pids,
+		NetCLS,
+		NetPrio,
+		PerfEvent,
+		Cpuset,
+		Cpu,
+		Cpuacct,
+		Memory,
+		Blkio,
+		Rdma,
+		Misc,
+		Devices,
+		Hugetlb,
+	}
+	var named []string
+	for name := range mounts {
+		if !containsName(order, name) {
+			named = append(named, string(name))
+		}
+	}
+	sort.Strings(named)
+	for _, name := range named {
+		order = append(order, Name(name))
+	}
+	var subsystems []Subsystem
+	for _, name := range order {
+		mount, ok := mounts[name]
+		if !ok {
+			continue
+		}
+		// only add the devices cgroup if we are not in a user namespace
+		// because modifications are not allowed
+		if name == Devices && isUserNS {
+			continue
+		}
+		s, err := mountedSubsystem(name, mount)
+		if err != nil {
+			// skip the hugetlb cgroup if the host has no huge pages
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		subsystems = append(subsystems, s)
+	}
+	return subsystems, nil
+}
+
+// mountedSubsystem returns the subsystem for the hierarchy mounted at mount
+func mountedSubsystem(name Name, mount string) (Subsystem, error) {
+	switch name {
+	case Devices:
+		return &devicesController{root: mount}, nil
+	case Hugetlb:
+		sizes, err := hugePageSizes()
+		if err != nil {
+			return nil, err
 		}
+		return &hugetlbController{root: mount, sizes: sizes}, nil
+	case Freezer:
+		return &freezerController{root: mount}, nil
+	case Pids:
+		return &pidsController{root: mount}, nil
+	case NetCLS:
+		return &netclsController{root: mount}, nil
+	case NetPrio:
+		return &netprioController{root: mount}, nil
+	case PerfEvent:
+		return &PerfEventController{root: mount}, nil
+	case Cpuset:
+		return &cpusetController{root: mount}, nil
+	case Cpu:
+		return &cpuController{root: mount}, nil
+	case Cpuacct:
+		return &cpuacctController{root: mount}, nil
+	case Memory:
+		return &memoryController{root: mount}, nil
+	case Blkio:
+		return &blkioController{root: mount}, nil
+	case Rdma:
+		return &rdmaController{root: mount}, nil
+	case Misc:
+		return &miscController{root: mount}, nil
 	}
-	return enabled, nil
+	return &namedController{root: mount, name: name}, nil
 }
 
 // v1MountPoint returns the mount point where the cgroup
@@ -0,0 +1,103 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package cgroups
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseMountinfo(t *testing.T) {
+	const data = `25 30 0:23 / /sys rw,nosuid,nodev,noexec,relatime shared:7 - sysfs sysfs rw
+28 25 0:26 / /sys/fs/cgroup ro,nosuid,nodev,noexec shared:9 - tmpfs tmpfs ro,mode=755
+29 28 0:27 / /sys/fs/cgroup/unified rw,nosuid,nodev,noexec,relatime shared:10 - cgroup2 cgroup2 rw,nsdelegate
+30 28 0:28 / /sys/fs/cgroup/systemd rw,nosuid,nodev,noexec,relatime shared:11 - cgroup cgroup rw,xattr,name=systemd
+33 28 0:31 / /sys/fs/cgroup/cpu,cpuacct rw,nosuid,nodev,noexec,relatime shared:15 - cgroup cgroup rw,cpu,cpuacct
+34 28 0:32 / /sys/fs/cgroup/memory rw,nosuid,nodev,noexec,relatime shared:16 - cgroup cgroup rw,memory
+35 28 0:33 / /sys/fs/cgroup/cpuset rw,nosuid,nodev,noexec,relatime shared:17 - cgroup cgroup rw,cpuset,clone_children
+36 28 0:34 / /sys/fs/cgroup/elogind rw,nosuid,nodev,noexec,relatime shared:18 - cgroup cgroup rw,name=elogind,release_agent=/lib/elogind/elogind-cgroups-agent
+37 34 0:32 / /mnt/memory rw,relatime shared:16 - cgroup cgroup rw,memory`
+	mounts, err := parseMountinfo(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[Name]string{
+		"systemd": "/sys/fs/cgroup/systemd",
+		"elogind": "/sys/fs/cgroup/elogind",
+		Cpu:       "/sys/fs/cgroup/cpu,cpuacct",
+		Cpuacct:   "/sys/fs/cgroup/cpu,cpuacct",
+		Memory:    "/sys/fs/cgroup/memory",
+		Cpuset:    "/sys/fs/cgroup/cpuset",
+	}
+	if len(mounts) != len(expected) {
+		t.Fatalf("expected mounts %v but received %v", expected, mounts)
+	}
+	for name, mount := range expected {
+		if mounts[name] != mount {
+			t.Errorf("expected %s to be mounted at %q but received %q", name, mount, mounts[name])
+		}
+	}
+	subsystems, err := mountedSubsystems(mounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, s := range subsystems {
+		names = append(names, string(s.Name()))
+	}
+	if got := strings.Join(names, ","); got != "systemd,cpuset,cpu,cpuacct,memory,elogind" {
+		t.Fatalf("unexpected subsystems %q", got)
+	}
+	if p := subsystems[2].(pather).Path("/test"); p != "/sys/fs/cgroup/cpu,cpuacct/test" {
+		t.Fatalf("unexpected cpu path %q", p)
+	}
+}
+
+func TestV1Root(t *testing.T) {
+	root, err := ioutil.TempDir("", "cgroups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	if _, err := V1Root(root)(); err != ErrMountPointNotExist {
+		t.Fatalf("expected error %q but received %v", ErrMountPointNotExist, err)
+	}
+	for _, name := range []string{"systemd", "memory", "pids"} {
+		if err := os.Mkdir(filepath.Join(root, name), defaultDirPerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	subsystems, err := V1Root(root)()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Name{"systemd", Pids, Memory}
+	if len(subsystems) != len(expected) {
+		t.Fatalf("expected %d subsystems but received %d", len(expected), len(subsystems))
+	}
+	for i, s := range subsystems {
+		if s.Name() != expected[i] {
+			t.Errorf("expected subsystem %q but received %q", expected[i], s.Name())
+		}
+		if p := s.(pather).Path("test"); p != filepath.Join(root, string(expected[i]), "test") {
+			t.Errorf("unexpected path %q for subsystem %q", p, s.Name())
+		}
+	}
+}